@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+data "signoz_alerts" "all" {}
+
+# Write the import blocks of every alert, then run in that directory:
+#   terraform plan -generate-config-out=alerts.tf
+resource "local_file" "alert_imports" {
+  filename = "${path.module}/adopt/imports.tf"
+  content  = data.signoz_alerts.all.import_blocks
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+data "signoz_dashboard_template" "clickhouse" {
+  name = "clickhouse/clickhouse-overview"
+}
+
+resource "signoz_dashboard" "clickhouse" {
+  collapsable_rows_migrated = true
+  uploaded_grafana          = false
+  version                   = "v4"
+
+  title       = data.signoz_dashboard_template.clickhouse.title
+  description = data.signoz_dashboard_template.clickhouse.description
+  name        = data.signoz_dashboard_template.clickhouse.title
+  layout      = data.signoz_dashboard_template.clickhouse.layout
+  panel_map   = data.signoz_dashboard_template.clickhouse.panel_map
+  tags        = data.signoz_dashboard_template.clickhouse.tags
+  variables   = data.signoz_dashboard_template.clickhouse.variables
+  widgets     = data.signoz_dashboard_template.clickhouse.widgets
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+data "signoz_logs_pipeline_history" "this" {}
+
+output "last_deployment" {
+  value = {
+    version = data.signoz_logs_pipeline_history.this.versions[0].version
+    by      = data.signoz_logs_pipeline_history.this.versions[0].created_by_name
+    at      = data.signoz_logs_pipeline_history.this.versions[0].created_at
+    status  = data.signoz_logs_pipeline_history.this.versions[0].deploy_status
+  }
+}
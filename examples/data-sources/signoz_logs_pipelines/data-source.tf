@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+data "signoz_logs_pipelines" "deployed" {}
+
+output "enabled_pipelines" {
+  value = [for pipeline in data.signoz_logs_pipelines.deployed.pipelines : pipeline.name if pipeline.enabled]
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+locals {
+  condition = jsondecode(file("${path.module}/condition.json"))
+}
+
+data "signoz_query_range" "check" {
+  composite_query = jsonencode(local.condition.compositeQuery)
+  window          = "1h"
+}
+
+resource "signoz_alert" "example" {
+  alert      = "Example alert"
+  alert_type = "METRIC_BASED_ALERT"
+  condition  = jsonencode(local.condition)
+  rule_type  = "threshold_rule"
+  severity   = "warning"
+
+  lifecycle {
+    precondition {
+      condition     = data.signoz_query_range.check.has_data
+      error_message = "The alert query returns no data in this environment."
+    }
+  }
+}
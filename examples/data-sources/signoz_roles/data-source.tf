@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+data "signoz_roles" "all" {}
+
+variable "roster" {
+  description = "Roles of the users, by email."
+  type        = map(string)
+}
+
+locals {
+  roles = [for role in data.signoz_roles.all.roles : role.name]
+}
+
+check "roster_roles" {
+  assert {
+    condition     = alltrue([for role in values(var.roster) : contains(local.roles, role)])
+    error_message = "The roster holds roles unknown to SigNoz. Valid roles are: ${join(", ", local.roles)}."
+  }
+}
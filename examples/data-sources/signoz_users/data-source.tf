@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+data "signoz_users" "all" {}
+
+locals {
+  # Roles managed in Git, by email.
+  roster = {
+    "alice@example.com" = "ADMIN"
+    "bob@example.com"   = "VIEWER"
+  }
+  users = { for user in data.signoz_users.all.users : user.email => user.id }
+}
+
+resource "signoz_user_role" "roster" {
+  for_each = { for email, role in local.roster : email => role if contains(keys(local.users), email) }
+
+  user_id = local.users[each.key]
+  role    = each.value
+}
@@ -0,0 +1,31 @@
+# Provider functions require Terraform 1.8 or later.
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+locals {
+  # The log processors currently run by the collector.
+  collector = yamldecode(file("${path.module}/otel-collector-config.yaml"))
+}
+
+resource "signoz_logs_pipeline" "nginx" {
+  name = "nginx"
+
+  filter = jsonencode({
+    op = "AND"
+    items = [{
+      key   = { key = "service.name", dataType = "string", type = "resource" }
+      op    = "="
+      value = "nginx"
+    }]
+  })
+
+  processor = provider::signoz::collector_processors(jsonencode({
+    for id, processor in local.collector.processors : id => processor
+    if startswith(id, "transform/nginx") || startswith(id, "logstransform/nginx")
+  }))
+}
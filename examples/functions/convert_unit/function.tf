@@ -0,0 +1,13 @@
+# Provider functions require Terraform 1.8 or later.
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+output "latency_target" {
+  # 1500, to compare 1.5 seconds with a query returning milliseconds.
+  value = provider::signoz::convert_unit(1.5, "s", "ms")
+}
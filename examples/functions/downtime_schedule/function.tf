@@ -0,0 +1,13 @@
+# Provider functions require Terraform 1.8 or later.
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+output "weekend_maintenance" {
+  # {"timezone":"Europe/Berlin","recurrence":{"startTime":"2024-01-06T02:00:00Z","duration":"2h0m0s","repeatType":"weekly","repeatOn":["saturday","sunday"]}}
+  value = provider::signoz::downtime_schedule("Europe/Berlin", "2024-01-06T02:00:00Z", "2h", "weekly", ["saturday", "sunday"])
+}
@@ -0,0 +1,13 @@
+# Provider functions require Terraform 1.8 or later.
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+output "eval_window" {
+  # "15m0s"
+  value = provider::signoz::duration("15m")
+}
@@ -0,0 +1,27 @@
+# Provider functions require Terraform 1.8 or later.
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+locals {
+  dashboard = provider::signoz::merge_widgets(
+    file("${path.module}/widgets/service-overview.json"),
+    file("${path.module}/widgets/database.json"),
+  )
+}
+
+resource "signoz_dashboard" "service" {
+  collapsable_rows_migrated = true
+  description               = "Service overview composed from shared widget libraries"
+  name                      = "service-overview"
+  title                     = "Service overview"
+  uploaded_grafana          = false
+  variables                 = jsonencode({})
+  version                   = "v4"
+  widgets                   = local.dashboard.widgets
+  layout                    = local.dashboard.layout
+}
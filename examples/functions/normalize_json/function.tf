@@ -0,0 +1,16 @@
+# Provider functions require Terraform 1.8 or later.
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+locals {
+  condition = provider::signoz::normalize_json(file("${path.module}/condition.json"))
+}
+
+output "condition" {
+  value = local.condition
+}
@@ -0,0 +1,36 @@
+# Provider functions require Terraform 1.8 or later.
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+locals {
+  # The latency widget is defined once, with a [[service]] placeholder.
+  latency_widget = file("${path.module}/widgets/latency.json")
+
+  services = ["checkout", "payments"]
+
+  widgets = jsonencode([
+    for service in local.services : jsondecode(provider::signoz::render_widget(
+      local.latency_widget,
+      "latency-${service}",
+      { service = service },
+    ))
+  ])
+  dashboard = provider::signoz::merge_widgets(local.widgets)
+}
+
+resource "signoz_dashboard" "services" {
+  collapsable_rows_migrated = true
+  description               = "Latency of the services"
+  name                      = "services-latency"
+  title                     = "Services latency"
+  uploaded_grafana          = false
+  variables                 = jsonencode({})
+  version                   = "v4"
+  widgets                   = local.dashboard.widgets
+  layout                    = local.dashboard.layout
+}
@@ -0,0 +1,32 @@
+# Provider functions require Terraform 1.8 or later.
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+resource "signoz_alert" "high_memory" {
+  alert      = "High memory usage"
+  alert_type = "METRIC_BASED_ALERT"
+  rule_type  = "threshold_rule"
+  severity   = "warning"
+
+  condition = provider::signoz::threshold_condition(
+    jsonencode({
+      dataSource         = "metrics"
+      aggregateOperator  = "avg"
+      aggregateAttribute = { key = "k8s_node_memory_rss", dataType = "float64", type = "Gauge", isColumn = true }
+      timeAggregation    = "avg"
+      spaceAggregation   = "avg"
+      filters            = { op = "AND", items = [] }
+      groupBy            = [{ key = "k8s_node_name", dataType = "string", type = "tag" }]
+      stepInterval       = 60
+    }),
+    "above",
+    8,
+    "at_least_once",
+    { unit = "bytes", target_unit = "gbytes" },
+  )
+}
@@ -0,0 +1,56 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+locals {
+  tenants = ["acme", "globex", "initech"]
+}
+
+resource "signoz_alert_set" "tenant_errors" {
+  name = "tenant-errors"
+
+  alerts = {
+    for tenant in local.tenants : "High error rate - ${tenant}" => {
+      alert_type = "LOGS_BASED_ALERT"
+      severity   = "critical"
+      labels = {
+        tenant = tenant
+      }
+      condition = jsonencode({
+        compositeQuery = {
+          queryType = "builder"
+          panelType = "graph"
+          builderQueries = {
+            A = {
+              queryName          = "A"
+              expression         = "A"
+              dataSource         = "logs"
+              aggregateOperator  = "count"
+              aggregateAttribute = { key = "" }
+              filters = {
+                op = "AND"
+                items = [
+                  { key = { key = "tenant", type = "resource" }, op = "=", value = tenant },
+                  { key = { key = "severity_text", type = "" }, op = "=", value = "ERROR" },
+                ]
+              }
+            }
+          }
+        }
+        op                = "1"
+        target            = 100
+        matchType         = "1"
+        selectedQueryName = "A"
+      })
+    }
+  }
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+# Silences the alert during a two hours maintenance window.
+resource "signoz_alert_silence" "maintenance" {
+  name       = "database-maintenance"
+  comment    = "Planned database upgrade"
+  alert_ids  = ["0193a2b4-6f3e-7c1d-9a4b-2e5f8c7d1a30"]
+  start_time = "2024-01-01T02:00:00Z"
+  duration   = "2h"
+  timezone   = "Europe/Berlin"
+}
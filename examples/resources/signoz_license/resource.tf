@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+variable "signoz_license_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "signoz_license" "enterprise" {
+  key = var.signoz_license_key
+}
+
+output "license_plan" {
+  value = signoz_license.enterprise.plan
+}
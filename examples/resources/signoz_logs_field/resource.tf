@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+resource "signoz_logs_field" "http_status" {
+  name      = "http.status_code"
+  type      = "attributes"
+  data_type = "int64"
+}
+
+resource "signoz_logs_field" "k8s_namespace" {
+  name      = "k8s.namespace.name"
+  type      = "resources"
+  data_type = "string"
+}
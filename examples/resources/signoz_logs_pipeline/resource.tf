@@ -0,0 +1,59 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+resource "signoz_logs_pipeline" "nginx" {
+  name        = "nginx"
+  description = "Parse nginx access logs"
+
+  filter = jsonencode({
+    op = "AND"
+    items = [{
+      key   = { key = "service.name", dataType = "string", type = "resource" }
+      op    = "="
+      value = "nginx"
+    }]
+  })
+
+  processor = [
+    {
+      name = "parse access log"
+      grok_parser = {
+        pattern = "%%{IPORHOST:client_ip} - %%{DATA:user} \\[%%{HTTPDATE:time}\\] \"%%{WORD:method} %%{DATA:path} HTTP/%%{NUMBER:http_version}\" %%{NUMBER:status_code}"
+      }
+    },
+    {
+      name = "trace context"
+      trace_parser = {
+        trace_id = "attributes.trace_id"
+        span_id  = "attributes.span_id"
+      }
+    },
+    {
+      name = "severity from status"
+      severity_parser = {
+        parse_from = "attributes.status_code"
+        mapping = {
+          info  = ["2xx", "3xx"]
+          warn  = ["4xx"]
+          error = ["5xx"]
+        }
+      }
+    },
+    {
+      name = "drop user"
+      remove = {
+        field = "attributes.user"
+      }
+    },
+  ]
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+# Parses the trace context before the severity, whatever the order in which
+# the pipelines were created. Pipelines may be referenced by ID or by name.
+resource "signoz_logs_pipeline_order" "this" {
+  pipelines = [
+    signoz_logs_pipeline.trace_context.id,
+    signoz_logs_pipeline.severity.id,
+    "nginx-access-logs",
+  ]
+}
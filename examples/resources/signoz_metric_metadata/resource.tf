@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+resource "signoz_metric_metadata" "http_server_duration" {
+  metric_name = "http.server.duration"
+  type        = "Histogram"
+  description = "Duration of inbound HTTP requests."
+  unit        = "ms"
+  temporality = "Cumulative"
+}
+
+resource "signoz_metric_metadata" "queue_messages_processed" {
+  metric_name  = "queue.messages.processed"
+  type         = "Sum"
+  description  = "Number of messages processed by the workers."
+  temporality  = "Delta"
+  is_monotonic = true
+}
@@ -0,0 +1,49 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+# The receiver is submitted to the channels API as is, so any Alertmanager
+# receiver supported by SigNoz can be managed.
+resource "signoz_receiver_raw" "oncall" {
+  receiver = jsonencode({
+    name = "oncall-webhook"
+    webhook_configs = [
+      {
+        send_resolved = true
+        url           = "https://hooks.example.com/signoz"
+      },
+    ]
+  })
+
+  # Fail the apply if SigNoz cannot deliver a test notification.
+  send_test = true
+}
+
+variable "pagerduty_routing_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+# A write-only receiver keeps its secrets, such as the routing key, out of the
+# plan and the state. Increment receiver_wo_version to submit it again.
+resource "signoz_receiver_raw" "pagerduty" {
+  receiver_wo = jsonencode({
+    name = "oncall-pagerduty"
+    pagerduty_configs = [
+      {
+        routing_key = var.pagerduty_routing_key
+      },
+    ]
+  })
+  receiver_wo_version = 1
+}
@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+resource "signoz_sso_domain" "example" {
+  name        = "example.com"
+  sso_enabled = true
+  sso_type    = "SAML"
+
+  saml_config = {
+    entity_id   = "http://www.okta.com/exk1abcd"
+    idp_url     = "https://example.okta.com/app/signoz/exk1abcd/sso/saml"
+    certificate = file("okta.cert")
+  }
+}
+
+output "acs_url" {
+  value = signoz_sso_domain.example.acs_url
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+resource "signoz_traces_field" "http_route" {
+  name      = "http.route"
+  type      = "attributes"
+  data_type = "string"
+}
+
+resource "signoz_traces_field" "deployment_environment" {
+  name      = "deployment.environment"
+  type      = "resources"
+  data_type = "string"
+}
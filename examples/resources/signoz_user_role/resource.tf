@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    signoz = {
+      source = "registry.terraform.io/signoz/signoz"
+    }
+  }
+}
+
+provider "signoz" {
+  endpoint     = "http://localhost:3301"
+  access_token = "<SIGNOZ-API-KEY>"
+}
+
+resource "signoz_user_role" "oncall_lead" {
+  user_id         = "5b1f4a3e-8d2c-4b7a-9f10-2a3b4c5d6e7f"
+  role            = "ADMIN"
+  role_on_destroy = "VIEWER"
+}
@@ -1,18 +1,41 @@
 package attr
 
 const (
-	Alert             = "alert"
-	AlertType         = "alert_type"
-	Annotations       = "annotations"
-	BroadcastToAll    = "broadcast_to_all"
-	Condition         = "condition"
-	Disabled          = "disabled"
-	EvalWindow        = "eval_window"
-	Frequency         = "frequency"
-	PreferredChannels = "preferred_channels"
-	RuleType          = "rule_type"
-	Severity          = "severity"
-	Source            = "source"
-	State             = "state"
-	Summary           = "summary"
+	AbsentFor                  = "absent_for"
+	Alert                      = "alert"
+	AlertOnAbsent              = "alert_on_absent"
+	Alerts                     = "alerts"
+	AlertType                  = "alert_type"
+	Annotations                = "annotations"
+	BroadcastToAll             = "broadcast_to_all"
+	Channels                   = "channels"
+	ClickHouseQueries          = "clickhouse_queries"
+	CloneFrom                  = "clone_from"
+	Condition                  = "condition"
+	Disabled                   = "disabled"
+	DisabledNormalizationRules = "disabled_normalization_rules"
+	EvalWindow                 = "eval_window"
+	Frequency                  = "frequency"
+	GroupBy                    = "group_by"
+	IgnoreConditionFields      = "ignore_condition_fields"
+	ImportBlocks               = "import_blocks"
+	MatchType                  = "match_type"
+	NotificationSettings       = "notification_settings"
+	Op                         = "op"
+	PreferredChannels          = "preferred_channels"
+	PromQLQueries              = "promql_queries"
+	RecoveryTarget             = "recovery_target"
+	RepeatInterval             = "repeat_interval"
+	RuleType                   = "rule_type"
+	SelectedQuery              = "selected_query"
+	Severity                   = "severity"
+	SeverityChannels           = "severity_channels"
+	Source                     = "source"
+	State                      = "state"
+	Summary                    = "summary"
+	Target                     = "target"
+	TargetUnit                 = "target_unit"
+	Thresholds                 = "thresholds"
+	ValidateTemplates          = "validate_templates"
+	YAxisUnit                  = "y_axis_unit"
 )
@@ -0,0 +1,10 @@
+package attr
+
+const (
+	AdoptExisting     = "adopt_existing"
+	Receiver          = "receiver"
+	ReceiverWO        = "receiver_wo"
+	ReceiverWOVersion = "receiver_wo_version"
+	SendTest          = "send_test"
+	Type              = "type"
+)
@@ -2,16 +2,29 @@ package attr
 
 const (
 	CollapsableRowsMigrated = "collapsable_rows_migrated"
+	Collapsed               = "collapsed"
+	DriftDetection          = "drift_detection"
 	Layout                  = "layout"
+	LayoutFile              = "layout_file"
+	LayoutFileHash          = "layout_file_hash"
 	Name                    = "name"
+	NumericID               = "numeric_id"
 	PanelMap                = "panel_map"
+	Rows                    = "rows"
+	SkipDefaultTags         = "skip_default_tags"
 	Tags                    = "tags"
 	Title                   = "title"
 	UploadedGrafana         = "uploaded_grafana"
+	UUID                    = "uuid"
 	Variables               = "variables"
 	Widgets                 = "widgets"
+	WidgetsFile             = "widgets_file"
+	WidgetsFileHash         = "widgets_file_hash"
 	CreatedAt               = "created_at"
 	CreatedBy               = "created_by"
 	UpdatedAt               = "updated_at"
 	UpdatedBy               = "updated_by"
+	BaseURL                 = "base_url"
+	JSON                    = "json"
+	URL                     = "url"
 )
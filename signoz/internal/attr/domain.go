@@ -0,0 +1,15 @@
+package attr
+
+const (
+	ACSURL           = "acs_url"
+	Certificate      = "certificate"
+	ClientID         = "client_id"
+	ClientSecret     = "client_secret"
+	EntityID         = "entity_id"
+	GoogleAuthConfig = "google_auth_config"
+	IdpURL           = "idp_url"
+	RedirectURI      = "redirect_uri"
+	SAMLConfig       = "saml_config"
+	SSOEnabled       = "sso_enabled"
+	SSOType          = "sso_type"
+)
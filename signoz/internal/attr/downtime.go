@@ -0,0 +1,10 @@
+package attr
+
+const (
+	AlertIDs  = "alert_ids"
+	Comment   = "comment"
+	Duration  = "duration"
+	EndTime   = "end_time"
+	StartTime = "start_time"
+	Timezone  = "timezone"
+)
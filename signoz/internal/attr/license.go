@@ -0,0 +1,10 @@
+package attr
+
+const (
+	Features   = "features"
+	Key        = "key"
+	Plan       = "plan"
+	Status     = "status"
+	ValidFrom  = "valid_from"
+	ValidUntil = "valid_until"
+)
@@ -0,0 +1,8 @@
+package attr
+
+const (
+	DataType         = "data_type"
+	FieldType        = "type"
+	IndexGranularity = "index_granularity"
+	IndexType        = "index_type"
+)
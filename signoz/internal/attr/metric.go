@@ -0,0 +1,8 @@
+package attr
+
+const (
+	IsMonotonic = "is_monotonic"
+	MetricName  = "metric_name"
+	Temporality = "temporality"
+	Unit        = "unit"
+)
@@ -0,0 +1,29 @@
+package attr
+
+const (
+	Alias         = "alias"
+	CreatedByName = "created_by_name"
+	DeployResult  = "deploy_result"
+	DeployStatus  = "deploy_status"
+	Enabled       = "enabled"
+	Field         = "field"
+	Filter        = "filter"
+	From          = "from"
+	Mapping       = "mapping"
+	OnError       = "on_error"
+	OrderID       = "order_id"
+	OverwriteText = "overwrite_text"
+	ParseFrom     = "parse_from"
+	ParseTo       = "parse_to"
+	Pattern       = "pattern"
+	Pipelines     = "pipelines"
+	Processor     = "processor"
+	Regex         = "regex"
+	SpanID        = "span_id"
+	To            = "to"
+	TraceFlags    = "trace_flags"
+	TraceID       = "trace_id"
+	Value         = "value"
+	Versions      = "versions"
+	WaitForDeploy = "wait_for_deploy"
+)
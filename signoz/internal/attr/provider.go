@@ -1,8 +1,26 @@
 package attr
 
 const (
-	AccessToken  = "access_token"
-	Endpoint     = "endpoint"
-	HTTPMaxRetry = "http_max_retry"
-	HTTPTimeout  = "http_timeout"
+	AccessToken                     = "access_token"
+	AlertDisabledNormalizationRules = "alert_disabled_normalization_rules"
+	AlertIgnoreConditionFields      = "alert_ignore_condition_fields"
+	AlertManagedByLabel             = "alert_managed_by_label"
+	AlertMetadataLabels             = "alert_metadata_labels"
+	CircuitBreakerThreshold         = "circuit_breaker_threshold"
+	DefaultDashboardTags            = "default_dashboard_tags"
+	DryRun                          = "dry_run"
+	Endpoint                        = "endpoint"
+	HTTPCompression                 = "http_compression"
+	HTTPDisableHTTP2                = "http_disable_http2"
+	HTTPIdleConnTimeout             = "http_idle_conn_timeout"
+	HTTPMaxBodySize                 = "http_max_body_size"
+	HTTPMaxIdleConnsPerHost         = "http_max_idle_conns_per_host"
+	HTTPMaxRetry                    = "http_max_retry"
+	HTTPTimeout                     = "http_timeout"
+	Metrics                         = "metrics"
+	MetricsFile                     = "metrics_file"
+	Mock                            = "mock"
+	NormalizationWarnings           = "normalization_warnings"
+	Parallelism                     = "parallelism"
+	SkipNoopUpdates                 = "skip_noop_updates"
 )
@@ -0,0 +1,12 @@
+package attr
+
+const (
+	CompositeQuery = "composite_query"
+	HasData        = "has_data"
+	Legend         = "legend"
+	Query          = "query"
+	SeriesCount    = "series_count"
+	SeriesCounts   = "series_counts"
+	Step           = "step"
+	Window         = "window"
+)
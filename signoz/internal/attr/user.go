@@ -0,0 +1,11 @@
+package attr
+
+const (
+	Email         = "email"
+	Permissions   = "permissions"
+	Role          = "role"
+	RoleOnDestroy = "role_on_destroy"
+	Roles         = "roles"
+	UserID        = "user_id"
+	Users         = "users"
+)
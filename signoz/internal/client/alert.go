@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -17,13 +18,19 @@ const (
 	alertPath = "api/v1/rules"
 )
 
-// GetAlert - Returns specific alert.
+// GetAlert - Returns specific alert, from the list of alerts once more than one
+// alert is read.
 func (c *Client) GetAlert(ctx context.Context, alertID string) (*model.Alert, error) {
+	if alert, ok := c.alerts.get(ctx, c, alertID); ok {
+		tflog.Debug(ctx, "GetAlert: alert read from the list", map[string]any{"alertID": alertID})
+		return alert, nil
+	}
+
 	url, err := url.JoinPath(c.hostURL.String(), alertPath, alertID)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -54,9 +61,114 @@ func (c *Client) GetAlert(ctx context.Context, alertID string) (*model.Alert, er
 	return &bodyObj.Data, nil
 }
 
+// ListAlerts - Returns all alerts.
+func (c *Client) ListAlerts(ctx context.Context) ([]model.Alert, error) {
+	url, err := url.JoinPath(c.hostURL.String(), alertPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj alertListResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "ListAlerts: error while listing alerts", map[string]any{
+			"error": bodyObj.Error,
+			"type":  bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while listing alerts: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "ListAlerts: alerts fetched", map[string]any{"count": len(bodyObj.Data.Rules)})
+
+	return bodyObj.Data.Rules, nil
+}
+
+// FindAlertByName - Returns the alert with the name. The alerts API has no
+// lookup by name, so the alert is looked up in the list. SigNoz accepts alerts
+// sharing a name, which is reported as an error.
+func (c *Client) FindAlertByName(ctx context.Context, name string) (*model.Alert, error) {
+	alerts, err := c.ListAlerts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *model.Alert
+	for _, alert := range alerts {
+		if alert.Alert != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("several alerts are named %s, such as %s and %s", name, found.ID, alert.ID)
+		}
+		found = &alert
+	}
+	if found == nil {
+		return nil, fmt.Errorf("alert %s: %w", name, ErrNotFound)
+	}
+
+	return found, nil
+}
+
+// CreateOrAdoptAlert - Updates the alert with the name of the payload, taking
+// it over instead of creating a duplicate, or creates the alert if there is
+// none.
+func (c *Client) CreateOrAdoptAlert(ctx context.Context, alertPayload *model.Alert) (*model.Alert, error) {
+	existing, err := c.FindAlertByName(ctx, alertPayload.Alert)
+	if errors.Is(err, ErrNotFound) {
+		return c.CreateAlert(ctx, alertPayload)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "CreateOrAdoptAlert: adopting existing alert", map[string]any{"alertID": existing.ID})
+	alertPayload.ID = existing.ID
+	if err := c.UpdateAlert(ctx, existing.ID, alertPayload); err != nil {
+		return nil, err
+	}
+
+	return c.GetAlert(ctx, existing.ID)
+}
+
+// setAlertSchema - Selects the shape of the condition thresholds by server
+// version. Thresholds are sent with the v2alpha1 schema version, including the
+// ones set in the condition JSON, while servers older than
+// alertThresholdsVersion get the single target of the legacy shape.
+func (c *Client) setAlertSchema(alertPayload *model.Alert) error {
+	if !c.alertThresholds {
+		if err := alertPayload.SetLegacyThreshold(); err != nil {
+			return fmt.Errorf("SigNoz older than %s does not support thresholds: %w", alertThresholdsVersion, err)
+		}
+		return nil
+	}
+	if alertPayload.Condition != nil && alertPayload.Condition.Thresholds != nil {
+		alertPayload.SchemaVersion = model.AlertSchemaVersionV2Alpha1
+	}
+
+	return nil
+}
+
 // CreateAlert - Creates a new alert.
 func (c *Client) CreateAlert(ctx context.Context, alertPayload *model.Alert) (*model.Alert, error) {
 	alertPayload.SetSourceIfEmpty(c.hostURL.String())
+	alertPayload.SetVersionIfEmpty(alertPayload.Condition.Version())
+	alertPayload.SetVersionIfEmpty(c.alertPayloadVersion)
+	if err := c.setAlertSchema(alertPayload); err != nil {
+		return nil, err
+	}
 	rb, err := json.Marshal(alertPayload)
 	if err != nil {
 		return nil, err
@@ -66,7 +178,7 @@ func (c *Client) CreateAlert(ctx context.Context, alertPayload *model.Alert) (*m
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +210,19 @@ func (c *Client) CreateAlert(ctx context.Context, alertPayload *model.Alert) (*m
 
 // UpdateAlert - Updates an existing alert.
 func (c *Client) UpdateAlert(ctx context.Context, alertID string, alertPayload *model.Alert) error {
+	defer c.locks.Lock("alert/" + alertID)()
+	defer c.alerts.forget(alertID)
+
 	alertPayload.SetSourceIfEmpty(c.hostURL.String())
+	alertPayload.SetVersionIfEmpty(alertPayload.Condition.Version())
+	alertPayload.SetVersionIfEmpty(c.alertPayloadVersion)
+	if err := c.setAlertSchema(alertPayload); err != nil {
+		return err
+	}
+	if c.skipNoopUpdates && c.alertUnchanged(ctx, alertID, alertPayload) {
+		tflog.Info(ctx, "UpdateAlert: alert unchanged, skipping update", map[string]any{"alert": alertID})
+		return nil
+	}
 	rb, err := json.Marshal(alertPayload)
 	if err != nil {
 		return err
@@ -108,7 +232,7 @@ func (c *Client) UpdateAlert(ctx context.Context, alertID string, alertPayload *
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest(http.MethodPut, url, strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -138,13 +262,61 @@ func (c *Client) UpdateAlert(ctx context.Context, alertID string, alertPayload *
 	return nil
 }
 
+// SetAlertDisabled - Enables or disables an existing alert through the patch
+// API, leaving the rest of the rule untouched.
+func (c *Client) SetAlertDisabled(ctx context.Context, alertID string, disabled bool) error {
+	defer c.locks.Lock("alert/" + alertID)()
+	defer c.alerts.forget(alertID)
+
+	rb, err := json.Marshal(map[string]bool{"disabled": disabled})
+	if err != nil {
+		return err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), alertPath, alertID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	var bodyObj signozResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "SetAlertDisabled: error while patching alert", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+			"data":      bodyObj.Data,
+		})
+		return fmt.Errorf("error while patching alert: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "SetAlertDisabled: alert patched", map[string]any{"alertID": alertID, "disabled": disabled})
+
+	return nil
+}
+
 // DeleteAlert - Deletes an existing alert.
 func (c *Client) DeleteAlert(ctx context.Context, alertID string) error {
+	defer c.locks.Lock("alert/" + alertID)()
+	defer c.alerts.forget(alertID)
+
 	url, err := url.JoinPath(c.hostURL.String(), alertPath, alertID)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
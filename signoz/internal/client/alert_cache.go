@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// alertCache - Alerts listed once for the lifetime of the provider, i.e. one
+// plan or apply, so that refreshing many alerts costs a single list request.
+// The first alert is fetched on its own; the list is only requested from the
+// second read on. Written alerts are evicted and fetched on their own again.
+type alertCache struct {
+	mu     sync.Mutex
+	reads  int
+	loaded bool
+	failed bool
+	alerts map[string]model.Alert
+}
+
+func newAlertCache() *alertCache {
+	return &alertCache{alerts: map[string]model.Alert{}}
+}
+
+// get - Returns the cached alert, loading the list on the second read. It
+// returns false if the alert must be fetched on its own.
+func (a *alertCache) get(ctx context.Context, c *Client, alertID string) (*model.Alert, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.reads++
+	if !a.loaded && !a.failed && a.reads > 1 {
+		alerts, err := c.ListAlerts(ctx)
+		if err != nil {
+			tflog.Warn(ctx, "Unable to list alerts, fetching them one by one", map[string]any{"error": err.Error()})
+			a.failed = true
+			return nil, false
+		}
+		for _, alert := range alerts {
+			a.alerts[alert.ID] = alert
+		}
+		a.loaded = true
+	}
+
+	alert, ok := a.alerts[alertID]
+	if !ok {
+		return nil, false
+	}
+
+	return &alert, true
+}
+
+// forget - Evicts the alert after it was written.
+func (a *alertCache) forget(alertID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.alerts, alertID)
+}
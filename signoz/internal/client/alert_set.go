@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ListAlertSet - Returns the alerts of the set by name, found in a single list
+// request through the set label. SigNoz accepts alerts sharing a name, which
+// is reported as an error.
+func (c *Client) ListAlertSet(ctx context.Context, setName string) (map[string]model.Alert, error) {
+	alerts, err := c.ListAlerts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	set := map[string]model.Alert{}
+	for _, alert := range alerts {
+		if alert.Labels[model.AlertSetLabel] != setName {
+			continue
+		}
+		if found, ok := set[alert.Alert]; ok {
+			return nil, fmt.Errorf("several alerts of the set %s are named %s, such as %s and %s", setName, alert.Alert, found.ID, alert.ID)
+		}
+		set[alert.Alert] = alert
+	}
+
+	tflog.Debug(ctx, "ListAlertSet: alerts of the set fetched", map[string]any{"set": setName, "count": len(set)})
+
+	return set, nil
+}
+
+// UpsertAlerts - Creates the alerts without an ID, setting the ID assigned by
+// SigNoz, and updates the others. The alerts are written in parallel, up to
+// the parallelism of the client. Every alert is attempted; the errors are
+// returned together.
+func (c *Client) UpsertAlerts(ctx context.Context, alertPayloads []*model.Alert) error {
+	return c.forEachParallel(len(alertPayloads), func(i int) error {
+		alertPayload := alertPayloads[i]
+		if alertPayload.ID != "" {
+			if err := c.UpdateAlert(ctx, alertPayload.ID, alertPayload); err != nil {
+				return fmt.Errorf("alert %s: %w", alertPayload.Alert, err)
+			}
+			return nil
+		}
+
+		alert, err := c.CreateAlert(ctx, alertPayload)
+		if err != nil {
+			return fmt.Errorf("alert %s: %w", alertPayload.Alert, err)
+		}
+		alertPayload.ID = alert.ID
+
+		return nil
+	})
+}
+
+// DeleteAlerts - Deletes the alerts in parallel, up to the parallelism of the
+// client. Alerts already deleted are skipped.
+func (c *Client) DeleteAlerts(ctx context.Context, alertIDs []string) error {
+	return c.forEachParallel(len(alertIDs), func(i int) error {
+		err := c.DeleteAlert(ctx, alertIDs[i])
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("alert %s: %w", alertIDs[i], err)
+		}
+		return nil
+	})
+}
+
+// forEachParallel - Calls fn for each index from as many goroutines as the
+// parallelism of the client, and returns the joined errors.
+func (c *Client) forEachParallel(count int, fn func(i int) error) error {
+	indexes := make(chan int)
+	errs := make([]error, count)
+
+	var wg sync.WaitGroup
+	for range min(c.parallelism, count) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = fn(i)
+			}
+		}()
+	}
+	for i := range count {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
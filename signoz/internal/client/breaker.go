@@ -0,0 +1,133 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBreakerThreshold - Default number of consecutive failures after
+	// which requests fail fast.
+	DefaultBreakerThreshold int = 10
+
+	// breakerCooldown - Time requests fail fast before SigNoz is tried again.
+	breakerCooldown = 30 * time.Second
+)
+
+// ErrUnavailable - Returned without sending the request when SigNoz failed too
+// many times in a row.
+var ErrUnavailable = errors.New("SigNoz unavailable")
+
+// circuitBreaker - Counts the consecutive failed attempts, i.e. transport
+// errors and 5xx responses, across all requests. Once the threshold is
+// reached, requests fail fast instead of each retrying on its own, until the
+// cooldown elapses and a single attempt is let through. A nil breaker never
+// opens.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	failures  int
+	openedAt  time.Time
+	probing   bool
+}
+
+func newCircuitBreaker(threshold int) *circuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+
+	return &circuitBreaker{threshold: threshold}
+}
+
+// allow - Returns ErrUnavailable if the breaker is open. After the cooldown,
+// one attempt is allowed through to probe SigNoz, which is claimed by the
+// caller if probe is set.
+func (b *circuitBreaker) allow(probe bool) error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return nil
+	}
+	if remaining := breakerCooldown - time.Since(b.openedAt); remaining > 0 || b.probing {
+		return fmt.Errorf("%w, %d consecutive failures; not retrying for %s", ErrUnavailable, b.failures, remaining.Round(time.Second))
+	}
+	b.probing = probe
+
+	return nil
+}
+
+// isOpen - Returns true if requests currently fail fast.
+func (b *circuitBreaker) isOpen() bool {
+	if b == nil {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.failures >= b.threshold
+}
+
+// record - Records the outcome of an attempt.
+func (b *circuitBreaker) record(failed bool) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openedAt = time.Now()
+	}
+}
+
+// release - Lets another attempt probe SigNoz after a cancelled one.
+func (b *circuitBreaker) release() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+// breakerTransport - Fails attempts fast while the breaker is open and records
+// the outcome of the others.
+type breakerTransport struct {
+	next    http.RoundTripper
+	breaker *circuitBreaker
+}
+
+func (t breakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.breaker.allow(true); err != nil {
+		return nil, err
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if req.Context().Err() != nil {
+		// Cancelled attempts say nothing about SigNoz.
+		t.breaker.release()
+		return res, err
+	}
+	t.breaker.record(err != nil || res.StatusCode >= http.StatusInternalServerError)
+
+	return res, err
+}
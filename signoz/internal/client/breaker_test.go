@@ -0,0 +1,131 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// stubTransport - Answers every attempt with the status, counting them.
+type stubTransport struct {
+	status   int
+	attempts int
+}
+
+func (t *stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.attempts++
+	return &http.Response{StatusCode: t.status, Body: http.NoBody, Request: req}, nil
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	for _, threshold := range []int{0, -1} {
+		b := newCircuitBreaker(threshold)
+		if b != nil {
+			t.Fatalf("newCircuitBreaker(%d) = %+v, want nil", threshold, b)
+		}
+		for range 100 {
+			b.record(true)
+		}
+		if b.isOpen() {
+			t.Fatalf("disabled breaker is open")
+		}
+		if err := b.allow(true); err != nil {
+			t.Fatalf("disabled breaker failed fast: %v", err)
+		}
+	}
+}
+
+func TestCircuitBreakerTrip(t *testing.T) {
+	b := newCircuitBreaker(3)
+
+	b.record(true)
+	b.record(true)
+	if b.isOpen() {
+		t.Fatalf("breaker open below the threshold")
+	}
+	// A success resets the consecutive failures.
+	b.record(false)
+	b.record(true)
+	b.record(true)
+	if b.isOpen() {
+		t.Fatalf("breaker open after a success reset the failures")
+	}
+
+	b.record(true)
+	if !b.isOpen() {
+		t.Fatalf("breaker closed at the threshold")
+	}
+	if err := b.allow(false); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("allow() = %v, want ErrUnavailable", err)
+	}
+}
+
+func TestCircuitBreakerCooldown(t *testing.T) {
+	b := newCircuitBreaker(1)
+	b.record(true)
+	b.openedAt = time.Now().Add(-breakerCooldown)
+
+	// A single probe is let through after the cooldown.
+	if err := b.allow(true); err != nil {
+		t.Fatalf("probe not allowed after the cooldown: %v", err)
+	}
+	if err := b.allow(true); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("second probe allowed: %v", err)
+	}
+
+	// A failed probe opens the breaker for another cooldown.
+	b.record(true)
+	if err := b.allow(true); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("allow() after a failed probe = %v, want ErrUnavailable", err)
+	}
+
+	// A cancelled probe lets another one through.
+	b.openedAt = time.Now().Add(-breakerCooldown)
+	if err := b.allow(true); err != nil {
+		t.Fatalf("probe not allowed after the cooldown: %v", err)
+	}
+	b.release()
+	if err := b.allow(true); err != nil {
+		t.Fatalf("probe not allowed after a cancelled one: %v", err)
+	}
+
+	// A successful probe closes the breaker.
+	b.record(false)
+	if b.isOpen() {
+		t.Fatalf("breaker open after a successful probe")
+	}
+	if err := b.allow(true); err != nil {
+		t.Fatalf("allow() after a successful probe = %v", err)
+	}
+}
+
+func TestBreakerTransportFailFast(t *testing.T) {
+	next := &stubTransport{status: http.StatusBadGateway}
+	transport := breakerTransport{next: next, breaker: newCircuitBreaker(2)}
+
+	for range 2 {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost/api/v1/rules", nil)
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatalf("RoundTrip() = %v before the threshold", err)
+		}
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/api/v1/rules", nil)
+	if _, err := transport.RoundTrip(req); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("RoundTrip() = %v, want ErrUnavailable", err)
+	}
+	if next.attempts != 2 {
+		t.Fatalf("sent %d attempts, want 2", next.attempts)
+	}
+
+	// Client errors say nothing about the availability of SigNoz.
+	next = &stubTransport{status: http.StatusNotFound}
+	transport = breakerTransport{next: next, breaker: newCircuitBreaker(1)}
+	for range 3 {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost/api/v1/rules/1", nil)
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatalf("RoundTrip() = %v on a 404", err)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// channelPath - URL path for notification channel APIs.
+	channelPath = "api/v1/channels"
+	// channelTestPath - URL path for sending test notifications.
+	channelTestPath = "api/v1/testChannel"
+)
+
+// ListChannels - Returns all notification channels.
+func (c *Client) ListChannels(ctx context.Context) ([]model.Channel, error) {
+	url, err := url.JoinPath(c.hostURL.String(), channelPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj channelListResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "ListChannels: error while listing channels", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while listing channels: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "ListChannels: channels fetched", map[string]any{"count": len(bodyObj.Data)})
+
+	return bodyObj.Data, nil
+}
+
+// GetChannel - Returns specific notification channel.
+func (c *Client) GetChannel(ctx context.Context, channelID string) (*model.Channel, error) {
+	url, err := url.JoinPath(c.hostURL.String(), channelPath, channelID)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj channelResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "GetChannel: error while fetching channel", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		if bodyObj.ErrorType == errorTypeNotFound {
+			return nil, fmt.Errorf("channel %s: %w", channelID, ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("error while fetching channel: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "GetChannel: channel fetched", map[string]any{"channelID": channelID})
+
+	return &bodyObj.Data, nil
+}
+
+// FindChannel - Returns the notification channel with the name. The channels
+// API has no lookup by name, so the channel is looked up in the list.
+func (c *Client) FindChannel(ctx context.Context, name string) (*model.Channel, error) {
+	channels, err := c.ListChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range channels {
+		if channel.Name == name {
+			return &channel, nil
+		}
+	}
+
+	return nil, fmt.Errorf("channel %s: %w", name, ErrNotFound)
+}
+
+// CreateChannel - Creates a new notification channel from the Alertmanager
+// receiver JSON, submitted as is. The API does not return the ID of the
+// channel, so it is looked up by name.
+func (c *Client) CreateChannel(ctx context.Context, receiver string) (*model.Channel, error) {
+	name, err := model.ReceiverName(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), channelPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(receiver))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj signozResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "CreateChannel: error while creating channel", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while creating channel: %s", bodyObj.Error)
+	}
+
+	channel, err := c.FindChannel(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("channel %s was created but is missing from the channel list: %w", name, err)
+	}
+
+	tflog.Debug(ctx, "CreateChannel: channel created", map[string]any{"channelID": channel.ID})
+
+	return channel, nil
+}
+
+// UpdateChannel - Updates an existing notification channel with the
+// Alertmanager receiver JSON, submitted as is.
+func (c *Client) UpdateChannel(ctx context.Context, channelID, receiver string) error {
+	defer c.locks.Lock("channel/" + channelID)()
+
+	url, err := url.JoinPath(c.hostURL.String(), channelPath, channelID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, strings.NewReader(receiver))
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	var bodyObj signozResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "UpdateChannel: error while updating channel", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return fmt.Errorf("error while updating channel: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "UpdateChannel: channel updated", map[string]any{"channelID": channelID})
+
+	return nil
+}
+
+// TestChannel - Sends a test notification through the Alertmanager receiver
+// JSON, submitted as is. It fails if SigNoz cannot deliver the notification.
+func (c *Client) TestChannel(ctx context.Context, receiver string) error {
+	url, err := url.JoinPath(c.hostURL.String(), channelTestPath)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(receiver))
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	var bodyObj signozResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "TestChannel: error while testing channel", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return fmt.Errorf("error while testing channel: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "TestChannel: test notification sent")
+
+	return nil
+}
+
+// DeleteChannel - Deletes an existing notification channel.
+func (c *Client) DeleteChannel(ctx context.Context, channelID string) error {
+	defer c.locks.Lock("channel/" + channelID)()
+
+	url, err := url.JoinPath(c.hostURL.String(), channelPath, channelID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "DeleteChannel: channel deleted", map[string]any{"channelID": channelID})
+	return nil
+}
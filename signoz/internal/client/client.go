@@ -2,12 +2,16 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/normalize"
 	"github.com/gojek/heimdall/v7"
 	"github.com/gojek/heimdall/v7/httpclient"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -18,11 +22,29 @@ const (
 	DefaultHostURL string = "http://localhost:3301"
 	// DefaultHTTPTimeout - Default HTTP timeout.
 	DefaultHTTPTimeout time.Duration = 10 * time.Second
+	// DefaultParallelism - Default number of concurrent requests to SigNoz.
+	DefaultParallelism int = 10
+	// DefaultIdleConnTimeout - Default time an idle connection to SigNoz is kept open.
+	DefaultIdleConnTimeout time.Duration = 90 * time.Second
 
 	// SigNozAPIKeyHeader - SigNoz API key header.
 	SigNozAPIKeyHeader string = "SIGNOZ-API-KEY"
 )
 
+// ErrNotFound - Returned when the requested object does not exist in SigNoz.
+var ErrNotFound = errors.New("not found")
+
+// ErrNotModified - Returned by conditional requests when the object did not
+// change since the given ETag.
+var ErrNotModified = errors.New("not modified")
+
+// ErrBodyTooLarge - Returned when a request body exceeds the max body size, or
+// when SigNoz or a proxy in front of it rejects it as too large.
+var ErrBodyTooLarge = errors.New("request body too large")
+
+// errorTypeNotFound - Error type of SigNoz responses for missing objects.
+const errorTypeNotFound = "not_found"
+
 // Client - SigNoz API client.
 type Client struct {
 	agent      string
@@ -30,24 +52,123 @@ type Client struct {
 	version    string
 	hostURL    *url.URL
 	httpClient *httpclient.Client
+
+	// probeClient sends single-shot requests that must not be retried.
+	probeClient *http.Client
+
+	// alertPayloadVersion is set by NegotiateAPIVersion.
+	alertPayloadVersion string
+	// alertThresholds is unset by NegotiateAPIVersion for servers which do
+	// not support the thresholds of the v2alpha1 rule schema.
+	alertThresholds bool
+
+	// parallelism bounds the number of in-flight requests, enforced by slots.
+	parallelism int
+	slots       chan struct{}
+	// breaker fails requests fast after too many consecutive failures.
+	breaker          *circuitBreaker
+	breakerThreshold int
+	// locks serializes writes to the same object.
+	locks *keyedMutex
+	// alerts caches the listed alerts for reads.
+	alerts *alertCache
+	// pipelines batches the changes of the log pipelines.
+	pipelines *pipelineBatcher
+
+	// maxIdleConnsPerHost bounds the idle connections kept open to SigNoz. It
+	// defaults to the parallelism.
+	maxIdleConnsPerHost int
+	// idleConnTimeout is how long an idle connection is kept open.
+	idleConnTimeout time.Duration
+	// disableHTTP2 restricts the transport to HTTP/1.1.
+	disableHTTP2 bool
+
+	// mock serves requests from an in-memory mock of the API.
+	mock bool
+	// dryRun logs writes and fails them instead of sending them.
+	dryRun bool
+	// compression gzip compresses large request bodies.
+	compression bool
+	// skipNoopUpdates skips updates of alerts and dashboards that SigNoz
+	// already stores as sent.
+	skipNoopUpdates bool
+	// transcriptFile is the file every request and response is appended to,
+	// if set.
+	transcriptFile string
+	// metrics records the timing and sizes of every call, if enabled.
+	metrics *metricsRecorder
+	// maxBodySize bounds the size of request bodies, as sent. Zero means no limit.
+	maxBodySize int64
+	// ignoreConditionFields are provider-wide alert condition fields ignored
+	// when detecting drift.
+	ignoreConditionFields []string
+	// disabledNormalizationRules are the provider-wide rules not applied
+	// when normalizing alert conditions.
+	disabledNormalizationRules []string
+	// managedAlertLabels are stamped on every alert and hidden when reading it.
+	managedAlertLabels map[string]string
+	// defaultDashboardTags are added to the tags of every dashboard.
+	defaultDashboardTags []string
+	// normalizationWarnings reports the fields left out of comparisons.
+	normalizationWarnings bool
+	// normalized caches the normalized JSON of conditions and dashboards.
+	normalized *normalize.Cache
+}
+
+// doer - Sends an HTTP request and returns the response.
+type doer interface {
+	Do(req *http.Request) (*http.Response, error)
 }
 
 // NewClient - Creates a new client.
-func NewClient(endpoint, token string, httpTimeout time.Duration, httpRetryMax int, agent, version string) (*Client, error) {
+func NewClient(endpoint, token string, httpTimeout time.Duration, httpRetryMax int, agent, version string, opts ...Option) (*Client, error) {
 	host, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
 	}
-	client := httpclient.NewClient(
-		httpclient.WithHTTPClient(
-			&http.Client{
-				Timeout:   httpTimeout,
-				Transport: http.DefaultTransport,
-			},
-		),
+
+	c := &Client{
+		agent:   agent,
+		token:   token,
+		version: version,
+		hostURL: host,
+
+		alertPayloadVersion: defaultAlertPayloadVersion,
+		alertThresholds:     true,
+		parallelism:         DefaultParallelism,
+		breakerThreshold:    DefaultBreakerThreshold,
+		idleConnTimeout:     DefaultIdleConnTimeout,
+		locks:               newKeyedMutex(),
+		alerts:              newAlertCache(),
+		pipelines:           newPipelineBatcher(),
+		normalized:          normalize.NewCache(),
+	}
+	if key, value, err := model.ParseAlertLabel(model.AlertTerraformLabel); err == nil {
+		c.managedAlertLabels = map[string]string{key: value}
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.slots = make(chan struct{}, c.parallelism)
+	c.breaker = newCircuitBreaker(c.breakerThreshold)
+	if c.maxIdleConnsPerHost == 0 {
+		c.maxIdleConnsPerHost = c.parallelism
+	}
+
+	transport, err := c.newTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	c.probeClient = &http.Client{
+		Timeout:   httpTimeout,
+		Transport: transport,
+	}
+	c.httpClient = httpclient.NewClient(
+		httpclient.WithHTTPClient(c.probeClient),
 		httpclient.WithHTTPTimeout(httpTimeout),
 		httpclient.WithRetrier(
-			heimdall.NewRetrier(
+			c.newRetrier(
 				heimdall.NewConstantBackoff(
 					5*time.Second,
 					1*time.Second,
@@ -57,39 +178,242 @@ func NewClient(endpoint, token string, httpTimeout time.Duration, httpRetryMax i
 		httpclient.WithRetryCount(httpRetryMax),
 	)
 
-	return &Client{
-		agent:      agent,
-		token:      token,
-		version:    version,
-		hostURL:    host,
-		httpClient: client,
-	}, nil
+	return c, nil
+}
+
+// IgnoreConditionFields - Returns the provider-wide alert condition fields
+// ignored when detecting drift.
+func (c *Client) IgnoreConditionFields() []string {
+	return c.ignoreConditionFields
+}
+
+// DisabledNormalizationRules - Returns the provider-wide rules not applied
+// when normalizing alert conditions.
+func (c *Client) DisabledNormalizationRules() []string {
+	return c.disabledNormalizationRules
+}
+
+// ManagedAlertLabels - Returns the labels stamped on every alert by the provider.
+func (c *Client) ManagedAlertLabels() map[string]string {
+	return c.managedAlertLabels
+}
+
+// DefaultDashboardTags - Returns the tags added to every dashboard by the
+// provider.
+func (c *Client) DefaultDashboardTags() []string {
+	return c.defaultDashboardTags
+}
+
+// NormalizationWarnings - Returns true if the fields left out when comparing
+// JSON attributes are reported as warnings.
+func (c *Client) NormalizationWarnings() bool {
+	return c.normalizationWarnings
+}
+
+// NormalizeCache - Returns the cache of normalized JSON, shared by the
+// resources for the lifetime of the provider.
+func (c *Client) NormalizeCache() *normalize.Cache {
+	return c.normalized
+}
+
+// newTransport - Returns the transport used for requests to SigNoz.
+func (c *Client) newTransport() (http.RoundTripper, error) {
+	var transport http.RoundTripper
+	if c.mock {
+		transport = newMockTransport()
+	} else {
+		base, err := c.newHTTPTransport()
+		if err != nil {
+			return nil, err
+		}
+		transport = base
+	}
+	if c.transcriptFile != "" {
+		transport = newTranscriptTransport(transport, c.transcriptFile)
+	}
+
+	return countingTransport{next: breakerTransport{next: transport, breaker: c.breaker}}, nil
+}
+
+// newHTTPTransport - Returns the HTTP transport connecting to SigNoz.
+func (c *Client) newHTTPTransport() (*http.Transport, error) {
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("unexpected default transport type: %T", http.DefaultTransport)
+	}
+	transport = transport.Clone()
+	transport.MaxConnsPerHost = c.parallelism
+	transport.MaxIdleConnsPerHost = c.maxIdleConnsPerHost
+	transport.MaxIdleConns = max(transport.MaxIdleConns, c.maxIdleConnsPerHost)
+	transport.IdleConnTimeout = c.idleConnTimeout
+	if c.disableHTTP2 {
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+
+	return transport, nil
 }
 
+// newRetrier - Returns the retrier waiting with the backoff between attempts,
+// unless the circuit breaker is open, in which case the remaining attempts
+// fail at once.
+func (c *Client) newRetrier(backoff heimdall.Backoff) heimdall.Retriable {
+	return heimdall.NewRetrierFunc(func(retry int) time.Duration {
+		if c.breaker.isOpen() {
+			return 0
+		}
+
+		return backoff.Next(retry)
+	})
+}
+
+// doRequest - Sends the request with retries and returns the response body.
 func (c *Client) doRequest(ctx context.Context, req *http.Request) ([]byte, error) {
+	return c.send(ctx, c.httpClient, req)
+}
+
+// send - Sends the request using the given doer and returns the response body.
+func (c *Client) send(ctx context.Context, httpClient doer, req *http.Request) ([]byte, error) {
+	body, _, err := c.sendWithHeader(ctx, httpClient, req)
+
+	return body, err
+}
+
+// sendWithHeader - Sends the request using the given doer and returns the
+// response body and header. A 304 Not Modified response returns ErrNotModified.
+func (c *Client) sendWithHeader(ctx context.Context, httpClient doer, req *http.Request) ([]byte, http.Header, error) {
+	if c.metrics != nil {
+		return c.sendWithMetrics(ctx, httpClient, req)
+	}
+
+	return c.sendRequest(ctx, httpClient, req)
+}
+
+// sendRequest - Sends the request using the given doer and returns the
+// response body and header.
+func (c *Client) sendRequest(ctx context.Context, httpClient doer, req *http.Request) ([]byte, http.Header, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set(SigNozAPIKeyHeader, c.token)
 
+	requestID := newRequestID()
+	req.Header.Set(RequestIDHeader, requestID)
+
+	if c.dryRun && isWrite(req) {
+		return nil, nil, dryRunWrite(ctx, req)
+	}
+
+	if c.compression {
+		if err := compressRequest(req); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := c.breaker.allow(false); err != nil {
+		return nil, nil, err
+	}
+
+	if c.maxBodySize > 0 && req.ContentLength > c.maxBodySize {
+		return nil, nil, fmt.Errorf("%w: the %s body of %s exceeds the max body size of %s%s",
+			ErrBodyTooLarge, req.Method, formatSize(req.ContentLength), formatSize(c.maxBodySize), c.compressionHint())
+	}
+
 	tflog.Debug(ctx, "Making SigNoz API request", map[string]any{
-		"method": req.Method,
-		"url":    req.URL.String(),
-		"body":   req.Body,
+		"method":    req.Method,
+		"url":       req.URL.String(),
+		"body":      req.Body,
+		"requestID": requestID,
 	})
 
-	res, err := c.httpClient.Do(req)
+	select {
+	case c.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+	defer func() { <-c.slots }()
+
+	res, err := doWithContext(ctx, httpClient, req)
 	if err != nil {
-		return nil, err
+		return nil, nil, fmt.Errorf("%w%s", err, correlationSuffix(requestID, ""))
 	}
 	defer res.Body.Close()
 
+	serverTraceID := traceID(res)
+	tflog.Debug(ctx, "Received SigNoz API response", map[string]any{
+		"status":    res.StatusCode,
+		"requestID": requestID,
+		"traceID":   serverTraceID,
+	})
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, fmt.Errorf("%w%s", err, correlationSuffix(requestID, serverTraceID))
 	}
 
+	if res.StatusCode == http.StatusNotModified {
+		return nil, res.Header, ErrNotModified
+	}
+	if res.StatusCode == http.StatusRequestEntityTooLarge {
+		return nil, nil, fmt.Errorf("%w: SigNoz or a proxy in front of it rejected the %s body of %s with status %d%s%s",
+			ErrBodyTooLarge, req.Method, formatSize(req.ContentLength), res.StatusCode, c.compressionHint(),
+			correlationSuffix(requestID, serverTraceID))
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil, fmt.Errorf("%w: status: %d, body: %s%s", ErrNotFound, res.StatusCode, body, correlationSuffix(requestID, serverTraceID))
+	}
 	if res.StatusCode/100 > 2 {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, nil, fmt.Errorf("status: %d, body: %s%s", res.StatusCode, body, correlationSuffix(requestID, serverTraceID))
+	}
+
+	return body, res.Header, nil
+}
+
+// compressionHint - Suggests compressing request bodies if they are not yet.
+func (c *Client) compressionHint() string {
+	if c.compression {
+		return ""
 	}
 
-	return body, nil
+	return "; enabling http_compression may bring it under the limit"
+}
+
+// formatSize - Formats the size in bytes for diagnostics.
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	if size < unit*unit {
+		return fmt.Sprintf("%.1f KiB", float64(size)/unit)
+	}
+
+	return fmt.Sprintf("%.1f MiB", float64(size)/(unit*unit))
+}
+
+// doWithContext - Sends the request and stops waiting as soon as the context
+// is done. The retrier sleeps between attempts without watching the context,
+// so the request is run in the background and its response discarded if it
+// completes after cancellation.
+func doWithContext(ctx context.Context, httpClient doer, req *http.Request) (*http.Response, error) {
+	type result struct {
+		res *http.Response
+		err error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		res, err := httpClient.Do(req)
+		done <- result{res: res, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.res, r.err
+	case <-ctx.Done():
+		go func() {
+			if r := <-done; r.res != nil {
+				r.res.Body.Close()
+			}
+		}()
+		return nil, fmt.Errorf("request to SigNoz cancelled: %w", ctx.Err())
+	}
 }
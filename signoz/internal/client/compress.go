@@ -0,0 +1,74 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+)
+
+const (
+	// compressionMinSize - Request bodies smaller than this are sent uncompressed.
+	compressionMinSize = 1024
+
+	// contentEncodingGzip - Content encoding of gzip compressed bodies.
+	contentEncodingGzip = "gzip"
+)
+
+// compressRequest - Replaces the request body with its gzip compressed form.
+// Small bodies are left untouched as compression would not pay off.
+//
+// Responses don't need handling here: the transport advertises gzip support
+// and transparently decompresses response bodies.
+func compressRequest(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	if req.ContentLength > 0 && req.ContentLength < compressionMinSize {
+		return nil
+	}
+
+	// The body is compressed as it is read, so large dashboards are not held
+	// twice in memory.
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := io.Copy(writer, req.Body); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	if err := req.Body.Close(); err != nil {
+		return err
+	}
+
+	setRequestBody(req, buf.Bytes())
+	req.Header.Set("Content-Encoding", contentEncodingGzip)
+
+	return nil
+}
+
+// setRequestBody - Sets a replayable request body.
+func setRequestBody(req *http.Request, body []byte) {
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+}
+
+// decompressRequestBody - Returns the uncompressed body of the request.
+func decompressRequestBody(req *http.Request, body []byte) ([]byte, error) {
+	if req.Header.Get("Content-Encoding") != contentEncodingGzip {
+		return body, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
@@ -1,12 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
-	"strings"
 
 	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -19,18 +20,35 @@ const (
 
 // GetDashboard - Returns specific dashboard.
 func (c *Client) GetDashboard(ctx context.Context, dashboardUUID string) (*dashboardData, error) {
+	dashboard, _, err := c.GetDashboardIfChanged(ctx, dashboardUUID, "")
+
+	return dashboard, err
+}
+
+// GetDashboardIfChanged - Returns specific dashboard along with its ETag. If
+// the ETag of a previous response is given and the server reports that the
+// dashboard did not change, ErrNotModified is returned without downloading it.
+// SigNoz itself does not send ETags, but proxies in front of it may.
+func (c *Client) GetDashboardIfChanged(ctx context.Context, dashboardUUID, etag string) (*dashboardData, string, error) {
 	url, err := url.JoinPath(c.hostURL.String(), dashboardPath, dashboardUUID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
 	}
 
-	body, err := c.doRequest(ctx, req)
+	body, header, err := c.sendWithHeader(ctx, c.httpClient, req)
+	if errors.Is(err, ErrNotModified) {
+		tflog.Debug(ctx, "GetDashboard: dashboard not modified", map[string]any{"dashboardUUID": dashboardUUID, "etag": etag})
+		return nil, etag, err
+	}
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	tflog.Debug(ctx, "GetDashboard: Raw API response", map[string]any{
@@ -45,7 +63,7 @@ func (c *Client) GetDashboard(ctx context.Context, dashboardUUID string) (*dashb
 			"error": err.Error(),
 			"body":  string(body),
 		})
-		return nil, fmt.Errorf("failed to parse dashboard response JSON: %w", err)
+		return nil, "", fmt.Errorf("failed to parse dashboard response JSON: %w", err)
 	}
 
 	if bodyObj.Status != "success" || bodyObj.Error != "" {
@@ -54,13 +72,114 @@ func (c *Client) GetDashboard(ctx context.Context, dashboardUUID string) (*dashb
 			"errorType": bodyObj.ErrorType,
 			"data":      bodyObj.Data,
 		})
+		if bodyObj.ErrorType == errorTypeNotFound {
+			return nil, "", fmt.Errorf("dashboard %s: %w", dashboardUUID, ErrNotFound)
+		}
 
-		return &dashboardData{}, fmt.Errorf("error while fetching dashboard: %s", bodyObj.Error)
+		return &dashboardData{}, "", fmt.Errorf("error while fetching dashboard: %s", bodyObj.Error)
 	}
 
 	tflog.Debug(ctx, "GetDashboard: dashboard fetched", map[string]any{"dashboard": bodyObj.Data})
 
-	return &bodyObj.Data, nil
+	return &bodyObj.Data, header.Get("ETag"), nil
+}
+
+// ListDashboards - Returns all dashboards.
+func (c *Client) ListDashboards(ctx context.Context) ([]dashboardData, error) {
+	url, err := url.JoinPath(c.hostURL.String(), dashboardPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj dashboardListResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "ListDashboards: error while listing dashboards", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while listing dashboards: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "ListDashboards: dashboards fetched", map[string]any{"count": len(bodyObj.Data)})
+
+	return bodyObj.Data, nil
+}
+
+// FindDashboard - Returns the dashboard whose ID or UUID matches the
+// identifier. It resolves identifiers that no longer address the dashboard
+// directly, such as numeric IDs stored before a SigNoz migration.
+func (c *Client) FindDashboard(ctx context.Context, identifier string) (*dashboardData, error) {
+	dashboards, err := c.ListDashboards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dashboard := range dashboards {
+		if dashboard.ID == identifier || dashboard.UUID == identifier {
+			return &dashboard, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dashboard %s: %w", identifier, ErrNotFound)
+}
+
+// FindDashboardByTitle - Returns the dashboard with the title. SigNoz accepts
+// dashboards sharing a title, which is reported as an error.
+func (c *Client) FindDashboardByTitle(ctx context.Context, title string) (*dashboardData, error) {
+	dashboards, err := c.ListDashboards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *dashboardData
+	for _, dashboard := range dashboards {
+		if dashboard.Data.Title != title {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("several dashboards are titled %s, such as %s and %s", title, found.Key(), dashboard.Key())
+		}
+		found = &dashboard
+	}
+	if found == nil {
+		return nil, fmt.Errorf("dashboard %s: %w", title, ErrNotFound)
+	}
+
+	return found, nil
+}
+
+// CreateOrAdoptDashboard - Updates the dashboard with the title of the payload,
+// taking it over instead of creating a duplicate, or creates the dashboard if
+// there is none.
+func (c *Client) CreateOrAdoptDashboard(ctx context.Context, dashboardPayload *model.Dashboard) (*dashboardData, error) {
+	existing, err := c.FindDashboardByTitle(ctx, dashboardPayload.Title)
+	if errors.Is(err, ErrNotFound) {
+		return c.CreateDashboard(ctx, dashboardPayload)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "CreateOrAdoptDashboard: adopting existing dashboard", map[string]any{"dashboard": existing.Key()})
+	if err := c.UpdateDashboard(ctx, existing.Key(), dashboardPayload); err != nil {
+		return nil, err
+	}
+
+	return c.GetDashboard(ctx, existing.Key())
 }
 
 // CreateDashboard - Creates a new dashboard.
@@ -75,7 +194,7 @@ func (c *Client) CreateDashboard(ctx context.Context, dashboardPayload *model.Da
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(rb))
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +226,13 @@ func (c *Client) CreateDashboard(ctx context.Context, dashboardPayload *model.Da
 
 // UpdateDashboard - Updates an existing dashboard.
 func (c *Client) UpdateDashboard(ctx context.Context, dashboardUUID string, dashboardPayload *model.Dashboard) error {
+	defer c.locks.Lock("dashboard/" + dashboardUUID)()
+
 	dashboardPayload.SetSourceIfEmpty(c.hostURL.String())
+	if c.skipNoopUpdates && c.dashboardUnchanged(ctx, dashboardUUID, dashboardPayload) {
+		tflog.Info(ctx, "UpdateDashboard: dashboard unchanged, skipping update", map[string]any{"dashboard": dashboardUUID})
+		return nil
+	}
 	rb, err := json.Marshal(dashboardPayload)
 	if err != nil {
 		return err
@@ -117,7 +242,7 @@ func (c *Client) UpdateDashboard(ctx context.Context, dashboardUUID string, dash
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest(http.MethodPut, url, strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(rb))
 	if err != nil {
 		return err
 	}
@@ -149,11 +274,13 @@ func (c *Client) UpdateDashboard(ctx context.Context, dashboardUUID string, dash
 
 // DeleteDashboard - Deletes an existing dashboard.
 func (c *Client) DeleteDashboard(ctx context.Context, dashboardUUID string) error {
+	defer c.locks.Lock("dashboard/" + dashboardUUID)()
+
 	url, err := url.JoinPath(c.hostURL.String(), dashboardPath, dashboardUUID)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return err
 	}
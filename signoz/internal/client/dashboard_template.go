@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultDashboardTemplatesURL - Base URL of the SigNoz dashboards repository.
+	DefaultDashboardTemplatesURL = "https://raw.githubusercontent.com/SigNoz/dashboards/main"
+
+	// dashboardTemplateMaxSize - Largest dashboard template read, in bytes.
+	dashboardTemplateMaxSize = 32 << 20
+)
+
+// DashboardTemplateURL - Returns the URL of the named template under the base
+// URL, adding the .json extension if missing.
+func DashboardTemplateURL(baseURL, name string) (string, error) {
+	name = strings.TrimSuffix(strings.Trim(name, "/"), ".json") + ".json"
+
+	return url.JoinPath(baseURL, strings.Split(name, "/")...)
+}
+
+// GetDashboardTemplate - Downloads a dashboard template, such as one of the
+// SigNoz dashboards repository. The request is sent without the SigNoz
+// credentials, as the template is hosted outside of SigNoz, and it is not
+// counted by the circuit breaker.
+func (c *Client) GetDashboardTemplate(ctx context.Context, templateURL string) (*model.Dashboard, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, templateURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	tflog.Debug(ctx, "GetDashboardTemplate: downloading dashboard template", map[string]any{"url": templateURL})
+
+	httpClient := &http.Client{Timeout: c.probeClient.Timeout}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, dashboardTemplateMaxSize))
+	if err != nil {
+		return nil, "", err
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return nil, "", fmt.Errorf("dashboard template %s: %w", templateURL, ErrNotFound)
+	}
+	if res.StatusCode/100 != 2 {
+		return nil, "", fmt.Errorf("dashboard template %s: status: %d, body: %s", templateURL, res.StatusCode, body)
+	}
+
+	var dashboard model.Dashboard
+	if err := json.Unmarshal(body, &dashboard); err != nil {
+		return nil, "", fmt.Errorf("dashboard template %s is not a dashboard: %w", templateURL, err)
+	}
+
+	return &dashboard, string(body), nil
+}
@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// domainPath - URL path for domain APIs.
+	domainPath = "api/v1/domains"
+	// samlACSPath - URL path of the SAML assertion consumer service.
+	samlACSPath = "api/v1/complete/saml"
+)
+
+// ListDomains - Returns all domains of the organization.
+func (c *Client) ListDomains(ctx context.Context) ([]model.Domain, error) {
+	url, err := url.JoinPath(c.hostURL.String(), domainPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj domainListResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "ListDomains: error while listing domains", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while listing domains: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "ListDomains: domains fetched", map[string]any{"count": len(bodyObj.Data)})
+
+	return bodyObj.Data, nil
+}
+
+// GetDomain - Returns specific domain. The domains API has no single-item
+// endpoint, so the domain is looked up in the list.
+func (c *Client) GetDomain(ctx context.Context, domainID string) (*model.Domain, error) {
+	domains, err := c.ListDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, domain := range domains {
+		if domain.ID == domainID {
+			return &domain, nil
+		}
+	}
+
+	return nil, fmt.Errorf("domain %s: %w", domainID, ErrNotFound)
+}
+
+// CreateDomain - Creates a new domain.
+func (c *Client) CreateDomain(ctx context.Context, domainPayload *model.Domain) (*model.Domain, error) {
+	rb, err := json.Marshal(domainPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), domainPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj domainResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "CreateDomain: error while creating domain", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while creating domain: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "CreateDomain: domain created", map[string]any{"domainID": bodyObj.Data.ID})
+
+	return &bodyObj.Data, nil
+}
+
+// UpdateDomain - Updates an existing domain.
+func (c *Client) UpdateDomain(ctx context.Context, domainID string, domainPayload *model.Domain) error {
+	defer c.locks.Lock("domain/" + domainID)()
+
+	rb, err := json.Marshal(domainPayload)
+	if err != nil {
+		return err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), domainPath, domainID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	var bodyObj signozResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "UpdateDomain: error while updating domain", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return fmt.Errorf("error while updating domain: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "UpdateDomain: domain updated", map[string]any{"domainID": domainID})
+
+	return nil
+}
+
+// DeleteDomain - Deletes an existing domain.
+func (c *Client) DeleteDomain(ctx context.Context, domainID string) error {
+	defer c.locks.Lock("domain/" + domainID)()
+
+	url, err := url.JoinPath(c.hostURL.String(), domainPath, domainID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "DeleteDomain: domain deleted", map[string]any{"domainID": domainID})
+	return nil
+}
+
+// SAMLACSURL - Returns the SAML assertion consumer service URL to register with the identity provider.
+func (c *Client) SAMLACSURL() string {
+	acsURL, err := url.JoinPath(c.hostURL.String(), samlACSPath)
+	if err != nil {
+		return ""
+	}
+
+	return acsURL
+}
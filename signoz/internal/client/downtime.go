@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// downtimePath - URL path for planned downtime APIs.
+	downtimePath = "api/v1/downtime_schedules"
+)
+
+// ListDowntimes - Returns all planned downtimes.
+func (c *Client) ListDowntimes(ctx context.Context) ([]model.PlannedDowntime, error) {
+	url, err := url.JoinPath(c.hostURL.String(), downtimePath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj downtimeListResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "ListDowntimes: error while listing planned downtimes", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while listing planned downtimes: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "ListDowntimes: planned downtimes fetched", map[string]any{"count": len(bodyObj.Data)})
+
+	return bodyObj.Data, nil
+}
+
+// GetDowntime - Returns specific planned downtime.
+func (c *Client) GetDowntime(ctx context.Context, downtimeID string) (*model.PlannedDowntime, error) {
+	url, err := url.JoinPath(c.hostURL.String(), downtimePath, downtimeID)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj downtimeResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "GetDowntime: error while fetching planned downtime", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		if bodyObj.ErrorType == errorTypeNotFound {
+			return nil, fmt.Errorf("planned downtime %s: %w", downtimeID, ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("error while fetching planned downtime: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "GetDowntime: planned downtime fetched", map[string]any{"downtimeID": downtimeID})
+
+	return &bodyObj.Data, nil
+}
+
+// CreateDowntime - Creates a new planned downtime. Some SigNoz versions do not
+// return the created downtime, which is then looked up by name.
+func (c *Client) CreateDowntime(ctx context.Context, downtimePayload *model.PlannedDowntime) (*model.PlannedDowntime, error) {
+	rb, err := json.Marshal(downtimePayload)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), downtimePath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj downtimeResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "CreateDowntime: error while creating planned downtime", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while creating planned downtime: %s", bodyObj.Error)
+	}
+	if bodyObj.Data.ID != "" {
+		tflog.Debug(ctx, "CreateDowntime: planned downtime created", map[string]any{"downtimeID": bodyObj.Data.ID})
+		return &bodyObj.Data, nil
+	}
+
+	downtimes, err := c.ListDowntimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, downtime := range downtimes {
+		if downtime.Name == downtimePayload.Name {
+			tflog.Debug(ctx, "CreateDowntime: planned downtime created", map[string]any{"downtimeID": downtime.ID})
+			return &downtime, nil
+		}
+	}
+
+	return nil, fmt.Errorf("planned downtime %s was created but is missing from the list: %w", downtimePayload.Name, ErrNotFound)
+}
+
+// UpdateDowntime - Updates an existing planned downtime.
+func (c *Client) UpdateDowntime(ctx context.Context, downtimeID string, downtimePayload *model.PlannedDowntime) error {
+	defer c.locks.Lock("downtime/" + downtimeID)()
+
+	rb, err := json.Marshal(downtimePayload)
+	if err != nil {
+		return err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), downtimePath, downtimeID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	var bodyObj signozResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "UpdateDowntime: error while updating planned downtime", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return fmt.Errorf("error while updating planned downtime: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "UpdateDowntime: planned downtime updated", map[string]any{"downtimeID": downtimeID})
+
+	return nil
+}
+
+// DeleteDowntime - Deletes an existing planned downtime.
+func (c *Client) DeleteDowntime(ctx context.Context, downtimeID string) error {
+	defer c.locks.Lock("downtime/" + downtimeID)()
+
+	url, err := url.JoinPath(c.hostURL.String(), downtimePath, downtimeID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "DeleteDowntime: planned downtime deleted", map[string]any{"downtimeID": downtimeID})
+	return nil
+}
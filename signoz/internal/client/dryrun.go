@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ErrDryRun - Returned instead of sending a request creating, updating or
+// deleting an object while dry run is enabled.
+var ErrDryRun = errors.New("dry run")
+
+// isWrite - Returns true if the request creates, updates or deletes objects.
+// Queries sent with POST are reads.
+func isWrite(req *http.Request) bool {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return false
+	}
+
+	return strings.Trim(req.URL.Path, "/") != queryRangePath
+}
+
+// dryRunWrite - Logs the write exactly as it would be sent and returns
+// ErrDryRun along with its payload. The write fails rather than succeeding
+// with a synthetic response, so that the state never records objects SigNoz
+// does not have.
+func dryRunWrite(ctx context.Context, req *http.Request) error {
+	path := strings.Trim(req.URL.Path, "/")
+
+	var body []byte
+	if req.GetBody != nil {
+		reader, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+		if body, err = io.ReadAll(reader); err != nil {
+			return err
+		}
+	}
+
+	tflog.Info(ctx, "Dry run SigNoz request", map[string]any{
+		"method": req.Method,
+		"path":   path,
+		"body":   string(body),
+	})
+
+	if len(body) == 0 {
+		return fmt.Errorf("%w: %s %s was not sent to SigNoz", ErrDryRun, req.Method, path)
+	}
+
+	return fmt.Errorf("%w: %s %s was not sent to SigNoz, payload: %s", ErrDryRun, req.Method, path, body)
+}
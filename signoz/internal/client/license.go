@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// licensePath - URL path for license APIs.
+	licensePath = "api/v3/licenses"
+	// licenseActivePath - URL path of the active license.
+	licenseActivePath = "active"
+)
+
+// GetActiveLicense - Returns the active license.
+func (c *Client) GetActiveLicense(ctx context.Context) (*model.License, error) {
+	url, err := url.JoinPath(c.hostURL.String(), licensePath, licenseActivePath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj licenseResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "GetActiveLicense: error while fetching license", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+
+		return nil, fmt.Errorf("error while fetching license: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "GetActiveLicense: license fetched", map[string]any{"licenseID": bodyObj.Data.ID})
+
+	return &bodyObj.Data, nil
+}
+
+// ApplyLicense - Applies a license key.
+func (c *Client) ApplyLicense(ctx context.Context, key string) (*model.License, error) {
+	rb, err := json.Marshal(model.License{Key: key})
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), licensePath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj licenseResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "ApplyLicense: error while applying license", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while applying license: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "ApplyLicense: license applied", map[string]any{"licenseID": bodyObj.Data.ID})
+
+	return &bodyObj.Data, nil
+}
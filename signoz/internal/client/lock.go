@@ -0,0 +1,44 @@
+package client
+
+import "sync"
+
+// keyedMutex - Mutex per key, used to serialize writes to the same object so
+// parallel applies don't race each other into version conflicts.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	mu      sync.Mutex
+	waiters int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: map[string]*keyedLock{}}
+}
+
+// Lock - Locks the given key and returns the function releasing it.
+func (k *keyedMutex) Lock(key string) func() {
+	k.mu.Lock()
+	lock, ok := k.locks[key]
+	if !ok {
+		lock = &keyedLock{}
+		k.locks[key] = lock
+	}
+	lock.waiters++
+	k.mu.Unlock()
+
+	lock.mu.Lock()
+
+	return func() {
+		lock.mu.Unlock()
+
+		k.mu.Lock()
+		lock.waiters--
+		if lock.waiters == 0 {
+			delete(k.locks, key)
+		}
+		k.mu.Unlock()
+	}
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// logsFieldPath - URL path for log field APIs.
+	logsFieldPath = "api/v1/logs/fields"
+)
+
+// GetLogsFields - Returns the selected and interesting log fields.
+func (c *Client) GetLogsFields(ctx context.Context) (*model.LogsFields, error) {
+	url, err := url.JoinPath(c.hostURL.String(), logsFieldPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fields API responds without the data envelope.
+	var fields model.LogsFields
+	err = json.Unmarshal(body, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "GetLogsFields: log fields fetched", map[string]any{"selected": len(fields.Selected)})
+
+	return &fields, nil
+}
+
+// GetLogsField - Returns the selected log field with the given name and type.
+func (c *Client) GetLogsField(ctx context.Context, name, fieldType string) (*model.LogsField, error) {
+	fields, err := c.GetLogsFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields.Selected {
+		if field.Name == name && field.Type == fieldType {
+			return &field, nil
+		}
+	}
+
+	return nil, fmt.Errorf("log field %s/%s: %w", fieldType, name, ErrNotFound)
+}
+
+// UpdateLogsField - Selects or unselects a log field. Selecting a field
+// materializes it as an indexed column; unselecting drops the column.
+func (c *Client) UpdateLogsField(ctx context.Context, fieldPayload *model.LogsField) error {
+	defer c.locks.Lock("logs/field/" + fieldPayload.Type + "/" + fieldPayload.Name)()
+
+	rb, err := json.Marshal(fieldPayload)
+	if err != nil {
+		return err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), logsFieldPath)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "UpdateLogsField: log field updated", map[string]any{
+		"name":     fieldPayload.Name,
+		"selected": fieldPayload.Selected,
+	})
+
+	return nil
+}
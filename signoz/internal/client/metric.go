@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// metricPath - URL path for metric APIs.
+	metricPath = "api/v1/metrics"
+	// metricMetadataPath - URL path segment of the metadata of a metric.
+	metricMetadataPath = "metadata"
+)
+
+// GetMetricMetadata - Returns the metadata of the metric with the given name.
+func (c *Client) GetMetricMetadata(ctx context.Context, metricName string) (*model.MetricMetadata, error) {
+	url, err := url.JoinPath(c.hostURL.String(), metricPath, metricName, metricMetadataPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj metricMetadataResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "GetMetricMetadata: error while fetching metric metadata", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while fetching metric metadata: %s", bodyObj.Error)
+	}
+	if bodyObj.Data.Metadata == nil {
+		return nil, fmt.Errorf("metric metadata %s: %w", metricName, ErrNotFound)
+	}
+
+	metadata := &model.MetricMetadata{
+		MetricName:  metricName,
+		Type:        bodyObj.Data.Metadata.Type,
+		Description: bodyObj.Data.Metadata.Description,
+		Unit:        bodyObj.Data.Metadata.Unit,
+		Temporality: bodyObj.Data.Metadata.Temporality,
+		IsMonotonic: bodyObj.Data.Metadata.Monotonic,
+	}
+
+	tflog.Debug(ctx, "GetMetricMetadata: metric metadata fetched", map[string]any{"metric": metricName})
+
+	return metadata, nil
+}
+
+// UpdateMetricMetadata - Sets the type, description, unit and temporality of
+// a metric, overriding the metadata reported by its instrumentation.
+func (c *Client) UpdateMetricMetadata(ctx context.Context, metadataPayload *model.MetricMetadata) error {
+	defer c.locks.Lock("metric/" + metadataPayload.MetricName)()
+
+	rb, err := json.Marshal(metadataPayload)
+	if err != nil {
+		return err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), metricPath, metadataPayload.MetricName, metricMetadataPath)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "UpdateMetricMetadata: metric metadata updated", map[string]any{
+		"metric": metadataPayload.MetricName,
+		"type":   metadataPayload.Type,
+	})
+
+	return nil
+}
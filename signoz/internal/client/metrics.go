@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// metricsFileMode - Permissions of the metrics file when it is created.
+const metricsFileMode = 0o600
+
+// requestMetric - Timing and sizes of a single API call, including retries.
+// The wait is the time spent before the first attempt, mostly waiting for a
+// free slot under the parallelism limit.
+type requestMetric struct {
+	RequestID     string  `json:"requestID"`
+	Method        string  `json:"method"`
+	Path          string  `json:"path"`
+	Status        int     `json:"status,omitempty"`
+	Error         string  `json:"error,omitempty"`
+	Attempts      int64   `json:"attempts"`
+	DurationMS    float64 `json:"durationMs"`
+	WaitMS        float64 `json:"waitMs"`
+	RequestBytes  int64   `json:"requestBytes"`
+	ResponseBytes int     `json:"responseBytes"`
+}
+
+// metricsRecorder - Reports the metrics of every API call to the logs and,
+// optionally, appends them as JSON lines to a file.
+type metricsRecorder struct {
+	mu   sync.Mutex
+	file string
+}
+
+func newMetricsRecorder(file string) *metricsRecorder {
+	return &metricsRecorder{file: file}
+}
+
+// record - Reports the metric. Failures to write the file are logged only.
+func (m *metricsRecorder) record(ctx context.Context, metric requestMetric) {
+	tflog.Info(ctx, "SigNoz API call metrics", map[string]any{
+		"requestID":     metric.RequestID,
+		"method":        metric.Method,
+		"path":          metric.Path,
+		"status":        metric.Status,
+		"attempts":      metric.Attempts,
+		"durationMs":    metric.DurationMS,
+		"waitMs":        metric.WaitMS,
+		"requestBytes":  metric.RequestBytes,
+		"responseBytes": metric.ResponseBytes,
+	})
+
+	if m.file == "" {
+		return
+	}
+
+	line, err := json.Marshal(metric)
+	if err != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, metricsFileMode)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to open the metrics file", map[string]any{"file": m.file, "error": err.Error()})
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		tflog.Warn(ctx, "Unable to write the metrics file", map[string]any{"file": m.file, "error": err.Error()})
+	}
+}
+
+// statsKey - Context key of the stats of a request.
+type statsKey struct{}
+
+// requestStats - Attempts made to send a request, recorded by the counting
+// transport as the retrier replays it.
+type requestStats struct {
+	mu       sync.Mutex
+	attempts int64
+	status   int
+	first    time.Time
+}
+
+// withStats - Returns the request with stats recorded on every attempt.
+func withStats(req *http.Request) (*http.Request, *requestStats) {
+	stats := &requestStats{}
+
+	return req.WithContext(context.WithValue(req.Context(), statsKey{}, stats)), stats
+}
+
+// countingTransport - Records the attempts of the requests carrying stats.
+type countingTransport struct {
+	next http.RoundTripper
+}
+
+func (t countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	stats, ok := req.Context().Value(statsKey{}).(*requestStats)
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+
+	stats.mu.Lock()
+	stats.attempts++
+	if stats.first.IsZero() {
+		stats.first = time.Now()
+	}
+	stats.mu.Unlock()
+
+	res, err := t.next.RoundTrip(req)
+	if res != nil {
+		stats.mu.Lock()
+		stats.status = res.StatusCode
+		stats.mu.Unlock()
+	}
+
+	return res, err
+}
+
+// sendWithMetrics - Sends the request and records its metrics.
+func (c *Client) sendWithMetrics(ctx context.Context, httpClient doer, req *http.Request) ([]byte, http.Header, error) {
+	req, stats := withStats(req)
+	start := time.Now()
+
+	body, header, err := c.sendRequest(ctx, httpClient, req)
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	metric := requestMetric{
+		RequestID:     req.Header.Get(RequestIDHeader),
+		Method:        req.Method,
+		Path:          req.URL.Path,
+		Status:        stats.status,
+		Attempts:      stats.attempts,
+		DurationMS:    milliseconds(time.Since(start)),
+		RequestBytes:  req.ContentLength,
+		ResponseBytes: len(body),
+	}
+	if !stats.first.IsZero() {
+		metric.WaitMS = milliseconds(stats.first.Sub(start))
+	}
+	if err != nil {
+		metric.Error = err.Error()
+	}
+	c.metrics.record(ctx, metric)
+
+	return body, header, err
+}
+
+// milliseconds - Returns the duration in milliseconds.
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
@@ -0,0 +1,285 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// mockTimestamp - Fabricated timestamp for objects created in mock mode.
+	mockTimestamp = "1970-01-01T00:00:00Z"
+	// mockUser - Fabricated author for objects created in mock mode.
+	mockUser = "terraform-mock"
+)
+
+// mockTransport - In-memory stand-in for the SigNoz API. It logs every
+// intended request and fabricates deterministic responses, so plans and
+// module tests can run without a live SigNoz instance.
+type mockTransport struct {
+	mu      sync.Mutex
+	nextID  int
+	objects map[string]map[string]any
+	// pipelines holds the latest version of the log pipelines.
+	pipelines map[string]any
+}
+
+func newMockTransport() *mockTransport {
+	return &mockTransport{objects: map[string]map[string]any{}}
+}
+
+// mockWrappedCollections - Collections whose objects are returned inside a
+// data envelope with server-managed metadata.
+//
+//nolint:gochecknoglobals
+var mockWrappedCollections = map[string]bool{
+	dashboardPath: true,
+}
+
+// mockListKeys - Collections whose lists are returned inside an object under
+// the given key.
+//
+//nolint:gochecknoglobals
+var mockListKeys = map[string]string{
+	alertPath: "rules",
+}
+
+// RoundTrip - Serves the request from the in-memory store.
+func (m *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body, err = decompressRequestBody(req, body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	path := strings.Trim(req.URL.Path, "/")
+	tflog.Info(req.Context(), "Mock SigNoz request", map[string]any{
+		"method": req.Method,
+		"path":   path,
+		"body":   string(body),
+	})
+
+	if strings.HasPrefix(path, pipelinePath) {
+		return m.savePipelines(req, body)
+	}
+	if path == queryRangePath {
+		// Queries return no data, as nothing is ingested by the mock.
+		return mockResponse(req, http.StatusOK, map[string]any{"resultType": "", "result": []any{}})
+	}
+	if path == channelTestPath {
+		// Test notifications are not sent anywhere.
+		return mockResponse(req, http.StatusOK, "test alert sent")
+	}
+
+	collection, id := m.split(path)
+	switch {
+	case req.Method == http.MethodGet && id == "":
+		return m.list(req, collection)
+	case req.Method == http.MethodGet:
+		return m.get(req, path)
+	case req.Method == http.MethodPost:
+		return m.create(req, collection, body)
+	case req.Method == http.MethodPut:
+		return m.update(req, collection, path, body)
+	case req.Method == http.MethodPatch:
+		return m.patch(req, path, body)
+	case req.Method == http.MethodDelete:
+		return m.delete(req, path)
+	default:
+		return mockResponse(req, http.StatusMethodNotAllowed, nil)
+	}
+}
+
+// split - Splits the path into its collection and the object ID, if any.
+func (m *mockTransport) split(path string) (string, string) {
+	idx := strings.LastIndex(path, "/")
+	if idx < 0 {
+		return path, ""
+	}
+	collection, id := path[:idx], path[idx+1:]
+	if _, ok := m.objects[path]; ok {
+		return collection, id
+	}
+	if strings.HasPrefix(collection, "api/") && strings.Count(collection, "/") >= 2 {
+		return collection, id
+	}
+
+	return path, ""
+}
+
+func (m *mockTransport) list(req *http.Request, collection string) (*http.Response, error) {
+	keys := make([]string, 0)
+	for key := range m.objects {
+		if strings.HasPrefix(key, collection+"/") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	items := make([]map[string]any, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, m.objects[key])
+	}
+	if listKey, ok := mockListKeys[collection]; ok {
+		return mockResponse(req, http.StatusOK, map[string]any{listKey: items})
+	}
+
+	return mockResponse(req, http.StatusOK, items)
+}
+
+func (m *mockTransport) get(req *http.Request, path string) (*http.Response, error) {
+	object, ok := m.objects[path]
+	if !ok {
+		return mockResponse(req, http.StatusNotFound, nil)
+	}
+
+	return mockResponse(req, http.StatusOK, object)
+}
+
+func (m *mockTransport) create(req *http.Request, collection string, body []byte) (*http.Response, error) {
+	payload := map[string]any{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return mockResponse(req, http.StatusBadRequest, nil)
+		}
+	}
+
+	m.nextID++
+	id := fmt.Sprintf("mock-%d", m.nextID)
+	object := mockWrap(collection, id, payload)
+	m.objects[collection+"/"+id] = object
+
+	return mockResponse(req, http.StatusOK, object)
+}
+
+func (m *mockTransport) update(req *http.Request, collection, path string, body []byte) (*http.Response, error) {
+	if _, ok := m.objects[path]; !ok {
+		return mockResponse(req, http.StatusNotFound, nil)
+	}
+
+	payload := map[string]any{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return mockResponse(req, http.StatusBadRequest, nil)
+	}
+
+	id := path[strings.LastIndex(path, "/")+1:]
+	object := mockWrap(collection, id, payload)
+	m.objects[path] = object
+
+	return mockResponse(req, http.StatusOK, object)
+}
+
+// patch - Merges the top-level fields of the payload into the object.
+func (m *mockTransport) patch(req *http.Request, path string, body []byte) (*http.Response, error) {
+	object, ok := m.objects[path]
+	if !ok {
+		return mockResponse(req, http.StatusNotFound, nil)
+	}
+
+	payload := map[string]any{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return mockResponse(req, http.StatusBadRequest, nil)
+	}
+	for key, value := range payload {
+		object[key] = value
+	}
+
+	return mockResponse(req, http.StatusOK, object)
+}
+
+func (m *mockTransport) delete(req *http.Request, path string) (*http.Response, error) {
+	if _, ok := m.objects[path]; !ok {
+		return mockResponse(req, http.StatusNotFound, nil)
+	}
+	delete(m.objects, path)
+
+	return mockResponse(req, http.StatusOK, nil)
+}
+
+// savePipelines - Serves the log pipelines, which are saved all at once as a
+// new version and read back through the latest version.
+func (m *mockTransport) savePipelines(req *http.Request, body []byte) (*http.Response, error) {
+	if m.pipelines == nil {
+		m.pipelines = map[string]any{"version": 0, "pipelines": []any{}}
+	}
+	if req.Method == http.MethodGet {
+		return mockResponse(req, http.StatusOK, m.pipelines)
+	}
+
+	payload := map[string]any{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return mockResponse(req, http.StatusBadRequest, nil)
+	}
+	pipelines, _ := payload["pipelines"].([]any)
+	for _, pipeline := range pipelines {
+		if p, ok := pipeline.(map[string]any); ok && p["id"] == nil {
+			m.nextID++
+			p["id"] = fmt.Sprintf("mock-%d", m.nextID)
+		}
+	}
+
+	version, _ := m.pipelines["version"].(int)
+	m.pipelines = map[string]any{"version": version + 1, "pipelines": pipelines}
+
+	return mockResponse(req, http.StatusOK, m.pipelines)
+}
+
+// mockWrap - Adds the server-managed fields of the collection to the payload.
+func mockWrap(collection, id string, payload map[string]any) map[string]any {
+	if mockWrappedCollections[collection] {
+		return map[string]any{
+			"id":        id,
+			"createdAt": mockTimestamp,
+			"createdBy": mockUser,
+			"updatedAt": mockTimestamp,
+			"updatedBy": mockUser,
+			"data":      payload,
+		}
+	}
+
+	payload["id"] = id
+	payload["createAt"] = mockTimestamp
+	payload["createBy"] = mockUser
+	payload["updateAt"] = mockTimestamp
+	payload["updateBy"] = mockUser
+
+	return payload
+}
+
+// mockResponse - Fabricates a SigNoz API response.
+func mockResponse(req *http.Request, statusCode int, data any) (*http.Response, error) {
+	payload := signozResponse{Status: "success", Data: data}
+	if statusCode/100 != 2 {
+		payload = signozResponse{Status: "error", Error: http.StatusText(statusCode), ErrorType: "mock"}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
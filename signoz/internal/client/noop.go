@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/normalize"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// alertUnchanged - Returns true if SigNoz already stores the alert payload,
+// apart from the fields it manages itself. Any failure to tell returns false,
+// so that the update is sent.
+func (c *Client) alertUnchanged(ctx context.Context, alertID string, alertPayload *model.Alert) bool {
+	remote, err := c.GetAlert(ctx, alertID)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to read alert before updating it", map[string]any{"alert": alertID, "error": err.Error()})
+		return false
+	}
+
+	local := *alertPayload
+	stored := *remote
+	for _, alert := range []*model.Alert{&local, &stored} {
+		alert.ID = ""
+		alert.State = ""
+		alert.CreateAt = ""
+		alert.CreateBy = ""
+		alert.UpdateAt = ""
+		alert.UpdateBy = ""
+	}
+
+	return samePayload(ctx, local, stored, normalize.Options{
+		Subsystem: normalize.SubsystemAlertCondition,
+		Defaults:  normalize.AlertConditionDefaults,
+		Scope:     normalize.AlertConditionScope("condition"),
+		Disabled:  c.disabledNormalizationRules,
+		Cache:     c.normalized,
+	})
+}
+
+// dashboardUnchanged - Returns true if SigNoz already stores the dashboard
+// payload. Any failure to tell returns false, so that the update is sent.
+func (c *Client) dashboardUnchanged(ctx context.Context, dashboardUUID string, dashboardPayload *model.Dashboard) bool {
+	remote, err := c.GetDashboard(ctx, dashboardUUID)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to read dashboard before updating it", map[string]any{"dashboard": dashboardUUID, "error": err.Error()})
+		return false
+	}
+
+	return samePayload(ctx, dashboardPayload, remote.Data, normalize.Options{
+		Subsystem: normalize.SubsystemDashboard,
+		Cache:     c.normalized,
+	})
+}
+
+// samePayload - Returns true if both payloads are equal once normalized.
+func samePayload(ctx context.Context, local, remote any, opts normalize.Options) bool {
+	l, err := json.Marshal(local)
+	if err != nil {
+		return false
+	}
+	r, err := json.Marshal(remote)
+	if err != nil {
+		return false
+	}
+
+	comparison, err := normalize.Compare(ctx, string(l), string(r), opts)
+
+	return err == nil && comparison.Equal
+}
@@ -0,0 +1,147 @@
+package client
+
+import "time"
+
+// Option - Configures optional client behaviour.
+type Option func(c *Client)
+
+// WithParallelism - Limits the number of concurrent requests and connections to SigNoz.
+func WithParallelism(parallelism int) Option {
+	return func(c *Client) {
+		if parallelism > 0 {
+			c.parallelism = parallelism
+		}
+	}
+}
+
+// WithMaxBodySize - Limits the size of request bodies, as sent, in bytes.
+func WithMaxBodySize(maxBodySize int64) Option {
+	return func(c *Client) {
+		if maxBodySize > 0 {
+			c.maxBodySize = maxBodySize
+		}
+	}
+}
+
+// WithMaxIdleConnsPerHost - Limits the number of idle connections kept open to
+// SigNoz. It defaults to the parallelism.
+func WithMaxIdleConnsPerHost(maxIdleConnsPerHost int) Option {
+	return func(c *Client) {
+		if maxIdleConnsPerHost > 0 {
+			c.maxIdleConnsPerHost = maxIdleConnsPerHost
+		}
+	}
+}
+
+// WithIdleConnTimeout - Sets how long an idle connection to SigNoz is kept open.
+func WithIdleConnTimeout(idleConnTimeout time.Duration) Option {
+	return func(c *Client) {
+		if idleConnTimeout > 0 {
+			c.idleConnTimeout = idleConnTimeout
+		}
+	}
+}
+
+// WithDisableHTTP2 - Restricts the connections to SigNoz to HTTP/1.1.
+func WithDisableHTTP2(disableHTTP2 bool) Option {
+	return func(c *Client) {
+		c.disableHTTP2 = disableHTTP2
+	}
+}
+
+// WithMetrics - Reports the timing, attempts and sizes of every call to SigNoz
+// in the logs and, if the file is set, appends them to it as JSON lines.
+func WithMetrics(metrics bool, file string) Option {
+	return func(c *Client) {
+		if metrics || file != "" {
+			c.metrics = newMetricsRecorder(file)
+		}
+	}
+}
+
+// WithTranscript - Appends every request to SigNoz and its response, with
+// credentials redacted, as JSON lines to the file.
+func WithTranscript(file string) Option {
+	return func(c *Client) {
+		c.transcriptFile = file
+	}
+}
+
+// WithSkipNoopUpdates - Reads alerts and dashboards before updating them and
+// skips the update if SigNoz already stores them as sent.
+func WithSkipNoopUpdates(skipNoopUpdates bool) Option {
+	return func(c *Client) {
+		c.skipNoopUpdates = skipNoopUpdates
+	}
+}
+
+// WithBreakerThreshold - Sets the number of consecutive failures after which
+// requests fail fast. Zero disables the circuit breaker.
+func WithBreakerThreshold(threshold int) Option {
+	return func(c *Client) {
+		if threshold >= 0 {
+			c.breakerThreshold = threshold
+		}
+	}
+}
+
+// WithMock - Serves every request from an in-memory mock of the SigNoz API.
+func WithMock(mock bool) Option {
+	return func(c *Client) {
+		c.mock = mock
+	}
+}
+
+// WithDryRun - Only logs the writes to SigNoz and fails them with ErrDryRun
+// instead of sending them. Reads are still sent to SigNoz.
+func WithDryRun(dryRun bool) Option {
+	return func(c *Client) {
+		c.dryRun = dryRun
+	}
+}
+
+// WithIgnoreConditionFields - Sets the alert condition fields ignored when
+// comparing the configuration with SigNoz, in addition to the alert's own.
+func WithIgnoreConditionFields(fields []string) Option {
+	return func(c *Client) {
+		c.ignoreConditionFields = fields
+	}
+}
+
+// WithDisabledNormalizationRules - Sets the rules not applied when normalizing
+// alert conditions, which keep the defaults they would otherwise remove.
+func WithDisabledNormalizationRules(rules []string) Option {
+	return func(c *Client) {
+		c.disabledNormalizationRules = rules
+	}
+}
+
+// WithManagedAlertLabels - Sets the labels stamped on every alert, replacing the
+// default managedBy:terraform label. An empty map disables them.
+func WithManagedAlertLabels(labels map[string]string) Option {
+	return func(c *Client) {
+		c.managedAlertLabels = labels
+	}
+}
+
+// WithDefaultDashboardTags - Sets the tags added to every dashboard.
+func WithDefaultDashboardTags(tags []string) Option {
+	return func(c *Client) {
+		c.defaultDashboardTags = tags
+	}
+}
+
+// WithNormalizationWarnings - Reports the fields left out when comparing JSON
+// attributes, such as defaults added by SigNoz, as warnings.
+func WithNormalizationWarnings(normalizationWarnings bool) Option {
+	return func(c *Client) {
+		c.normalizationWarnings = normalizationWarnings
+	}
+}
+
+// WithCompression - Gzip compresses large request bodies.
+func WithCompression(compression bool) Option {
+	return func(c *Client) {
+		c.compression = compression
+	}
+}
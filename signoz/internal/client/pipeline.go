@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// pipelinePath - URL path for log pipeline APIs.
+	pipelinePath = "api/v1/logs/pipelines"
+	// pipelineLockKey - Lock key of the pipelines, which are saved all at once.
+	pipelineLockKey = "logs/pipelines"
+)
+
+// GetPipelines - Returns the latest version of the log pipelines.
+func (c *Client) GetPipelines(ctx context.Context) (*model.Pipelines, error) {
+	url, err := url.JoinPath(c.hostURL.String(), pipelinePath, "latest")
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj pipelinesResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "GetPipelines: error while fetching pipelines", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while fetching pipelines: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "GetPipelines: pipelines fetched", map[string]any{
+		"version": bodyObj.Data.Version,
+		"count":   len(bodyObj.Data.Pipelines),
+	})
+
+	return &bodyObj.Data, nil
+}
+
+// GetPipeline - Returns specific log pipeline.
+func (c *Client) GetPipeline(ctx context.Context, pipelineID string) (*model.Pipeline, error) {
+	pipelines, err := c.GetPipelines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pipeline := range pipelines.Pipelines {
+		if pipeline.ID == pipelineID {
+			return &pipeline, nil
+		}
+	}
+
+	return nil, fmt.Errorf("pipeline %s: %w", pipelineID, ErrNotFound)
+}
+
+// UpdatePipelines - Reads the latest pipelines, applies the change and saves
+// them as a new version. Every save replaces all pipelines, so the changes
+// applied in parallel are batched and saved as a single version.
+func (c *Client) UpdatePipelines(ctx context.Context, change func(pipelines []model.Pipeline) ([]model.Pipeline, error)) (*model.Pipelines, error) {
+	saved, _, err := c.updatePipelines(ctx, change)
+
+	return saved, err
+}
+
+// CreatePipeline - Adds the pipeline after the latest pipelines and returns it
+// as saved, with its ID, along with the saved pipelines.
+func (c *Client) CreatePipeline(ctx context.Context, pipeline model.Pipeline) (*model.Pipeline, *model.Pipelines, error) {
+	saved, created, err := c.updatePipelines(ctx, func(pipelines []model.Pipeline) ([]model.Pipeline, error) {
+		return append(pipelines, pipeline), nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(created) != 1 {
+		return nil, nil, fmt.Errorf("created pipeline %s not found in the saved pipelines", pipeline.Name)
+	}
+
+	return &created[0], saved, nil
+}
+
+// savePipelines - Saves the pipelines as a new version, in order.
+func (c *Client) savePipelines(ctx context.Context, pipelines []model.Pipeline) (*model.Pipelines, error) {
+	for i := range pipelines {
+		pipelines[i].OrderID = i + 1
+	}
+
+	rb, err := json.Marshal(model.Pipelines{Pipelines: pipelines})
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), pipelinePath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj pipelinesResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "savePipelines: error while saving pipelines", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while saving pipelines: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "savePipelines: pipelines saved", map[string]any{"version": bodyObj.Data.Version})
+
+	return &bodyObj.Data, nil
+}
+
+// WaitForPipelinesDeploy - Polls the pipelines until the version is deployed
+// to the collectors, or a later version is, which includes it. It fails if
+// the deployment fails or the context ends.
+func (c *Client) WaitForPipelinesDeploy(ctx context.Context, version int, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		pipelines, err := c.GetPipelines(ctx)
+		if err != nil {
+			return err
+		}
+
+		status, result := pipelines.DeployStatus, pipelines.DeployResult
+		if pipelines.Version != version {
+			for _, past := range pipelines.History {
+				if past.Version == version {
+					status, result = past.DeployStatus, past.DeployResult
+				}
+			}
+		}
+
+		switch {
+		case status == model.PipelineDeployStatusFailed:
+			return fmt.Errorf("deployment of pipelines version %d failed: %s", version, result)
+		case status == model.PipelineDeployStatusDeployed,
+			pipelines.Version > version && pipelines.DeployStatus == model.PipelineDeployStatusDeployed:
+			tflog.Debug(ctx, "WaitForPipelinesDeploy: pipelines deployed", map[string]any{"version": version})
+			return nil
+		}
+
+		tflog.Debug(ctx, "WaitForPipelinesDeploy: waiting for deployment", map[string]any{
+			"version": version,
+			"status":  status,
+		})
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("pipelines version %d not deployed, last status %q: %w", version, status, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// pipelineBatchWindow - How long the first change of a batch waits for
+	// the changes of the other pipelines applied in parallel.
+	pipelineBatchWindow = 250 * time.Millisecond
+	// pipelinePendingIDPrefix - Prefix of the IDs given to created pipelines
+	// until SigNoz assigns theirs.
+	pipelinePendingIDPrefix = "pending-"
+)
+
+// pipelineChange - Change of the pipelines, applied as part of a batch.
+type pipelineChange struct {
+	apply func(pipelines []model.Pipeline) ([]model.Pipeline, error)
+	// err is the error returned by apply, which only fails this change.
+	err error
+	// created are the pending IDs of the pipelines the change added.
+	created []string
+}
+
+// pipelineBatch - Changes of the pipelines saved together as one version,
+// so that SigNoz deploys the collector configuration once for all of them.
+type pipelineBatch struct {
+	changes []*pipelineChange
+	done    chan struct{}
+
+	saved *model.Pipelines
+	err   error
+	// ids are the IDs assigned by SigNoz by pending ID.
+	ids map[string]string
+}
+
+// pipelineBatcher - Collects the changes of the pipelines applied in
+// parallel. While a batch waits or is saved, the next one collects changes.
+type pipelineBatcher struct {
+	mu      sync.Mutex
+	pending *pipelineBatch
+}
+
+func newPipelineBatcher() *pipelineBatcher {
+	return &pipelineBatcher{}
+}
+
+// add - Adds the change to the pending batch. It returns true if the batch is
+// new, in which case the caller saves it.
+func (b *pipelineBatcher) add(change *pipelineChange) (*pipelineBatch, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	leader := b.pending == nil
+	if leader {
+		b.pending = &pipelineBatch{done: make(chan struct{})}
+	}
+	b.pending.changes = append(b.pending.changes, change)
+
+	return b.pending, leader
+}
+
+// take - Closes the batch to new changes.
+func (b *pipelineBatcher) take(batch *pipelineBatch) []*pipelineChange {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.pending == batch {
+		b.pending = nil
+	}
+
+	return batch.changes
+}
+
+// updatePipelines - Applies the change as part of a batch and returns the
+// saved pipelines along with the pipelines added by the change.
+func (c *Client) updatePipelines(ctx context.Context, apply func(pipelines []model.Pipeline) ([]model.Pipeline, error)) (*model.Pipelines, []model.Pipeline, error) {
+	change := &pipelineChange{apply: apply}
+	batch, leader := c.pipelines.add(change)
+
+	if leader {
+		time.Sleep(pipelineBatchWindow)
+		unlock := c.locks.Lock(pipelineLockKey)
+		changes := c.pipelines.take(batch)
+		batch.saved, batch.ids, batch.err = c.savePipelineChanges(ctx, changes)
+		unlock()
+		close(batch.done)
+	} else {
+		select {
+		case <-batch.done:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+
+	if batch.err != nil {
+		return nil, nil, batch.err
+	}
+	if change.err != nil {
+		return nil, nil, change.err
+	}
+
+	created := make([]model.Pipeline, 0, len(change.created))
+	for _, pendingID := range change.created {
+		id, ok := batch.ids[pendingID]
+		if !ok {
+			continue
+		}
+		for _, pipeline := range batch.saved.Pipelines {
+			if pipeline.ID == id {
+				created = append(created, pipeline)
+			}
+		}
+	}
+
+	return batch.saved, created, nil
+}
+
+// savePipelineChanges - Applies the changes to the latest pipelines in order
+// and saves them at once. A failed change is skipped and only fails its
+// caller. The returned map holds the IDs of the created pipelines.
+func (c *Client) savePipelineChanges(ctx context.Context, changes []*pipelineChange) (*model.Pipelines, map[string]string, error) {
+	current, err := c.GetPipelines(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pipelines := current.Pipelines
+	applied := 0
+	pending := map[string]bool{}
+	for _, change := range changes {
+		changed, err := change.apply(append([]model.Pipeline(nil), pipelines...))
+		if err != nil {
+			change.err = err
+			continue
+		}
+
+		// Created pipelines have no ID yet, so they are told apart with a
+		// pending ID until SigNoz assigns one.
+		for i := range changed {
+			if changed[i].ID == "" {
+				changed[i].ID = fmt.Sprintf("%s%d", pipelinePendingIDPrefix, len(pending)+1)
+				pending[changed[i].ID] = true
+				change.created = append(change.created, changed[i].ID)
+			}
+		}
+		pipelines = changed
+		applied++
+	}
+	if applied == 0 {
+		return current, nil, nil
+	}
+
+	pendingIDs := map[int]string{}
+	for i := range pipelines {
+		if pending[pipelines[i].ID] {
+			pendingIDs[i] = pipelines[i].ID
+			pipelines[i].ID = ""
+		}
+	}
+
+	tflog.Debug(ctx, "Saving pipeline changes", map[string]any{"changes": applied})
+	saved, err := c.savePipelines(ctx, pipelines)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// SigNoz saves the pipelines in the order they were sent.
+	ids := make(map[string]string, len(pendingIDs))
+	for i, pendingID := range pendingIDs {
+		for _, pipeline := range saved.Pipelines {
+			if pipeline.OrderID == i+1 {
+				ids[pendingID] = pipeline.ID
+			}
+		}
+	}
+
+	return saved, ids, nil
+}
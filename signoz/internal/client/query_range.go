@@ -0,0 +1,84 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// queryRangePath - URL path of the query range API.
+	queryRangePath = "api/v4/query_range"
+)
+
+// QueryRangeResult - Result of one query of a composite query. Time series
+// queries return series, while list queries, such as logs, return rows.
+type QueryRangeResult struct {
+	QueryName string           `json:"queryName"`
+	Series    []map[string]any `json:"series"`
+	List      []map[string]any `json:"list"`
+}
+
+// queryRangeResponse - Maps the response data of QueryRange.
+type queryRangeResponse struct {
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+	ErrorType string `json:"errorType"`
+	Data      struct {
+		ResultType string             `json:"resultType"`
+		Result     []QueryRangeResult `json:"result"`
+	} `json:"data"`
+}
+
+// QueryRange - Runs the composite query, such as the one of an alert
+// condition, between start and end with the step in seconds.
+func (c *Client) QueryRange(ctx context.Context, compositeQuery map[string]any, start, end time.Time, step int64) ([]QueryRangeResult, error) {
+	rb, err := json.Marshal(map[string]any{
+		"start":          start.UnixMilli(),
+		"end":            end.UnixMilli(),
+		"step":           step,
+		"compositeQuery": compositeQuery,
+		"variables":      map[string]any{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), queryRangePath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj queryRangeResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if bodyObj.Status != "success" || bodyObj.Error != "" {
+		tflog.Error(ctx, "QueryRange: error while running query", map[string]any{
+			"error":     bodyObj.Error,
+			"errorType": bodyObj.ErrorType,
+		})
+		return nil, fmt.Errorf("error while running query: %s", bodyObj.Error)
+	}
+
+	tflog.Debug(ctx, "QueryRange: query run", map[string]any{"results": len(bodyObj.Data.Result)})
+
+	return bodyObj.Data.Result, nil
+}
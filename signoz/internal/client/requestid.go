@@ -0,0 +1,59 @@
+package client
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// RequestIDHeader - Header carrying the ID generated for every API call.
+	RequestIDHeader = "X-Request-ID"
+)
+
+// traceIDHeaders - Response headers that may carry the server-side trace ID.
+//
+//nolint:gochecknoglobals
+var traceIDHeaders = []string{"X-Trace-Id", "Traceparent", "X-B3-Traceid"}
+
+// newRequestID - Returns a random request ID.
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// traceID - Returns the trace ID returned by the server, if any.
+func traceID(res *http.Response) string {
+	if res == nil {
+		return ""
+	}
+
+	for _, header := range traceIDHeaders {
+		value := res.Header.Get(header)
+		if value == "" {
+			continue
+		}
+		// traceparent is version-traceid-parentid-flags.
+		if parts := strings.Split(value, "-"); header == "Traceparent" && len(parts) == 4 {
+			return parts[1]
+		}
+		return value
+	}
+
+	return ""
+}
+
+// correlationSuffix - Describes the request and trace IDs to include in errors.
+func correlationSuffix(requestID, traceID string) string {
+	if traceID == "" {
+		return fmt.Sprintf(" (request ID: %s)", requestID)
+	}
+
+	return fmt.Sprintf(" (request ID: %s, trace ID: %s)", requestID, traceID)
+}
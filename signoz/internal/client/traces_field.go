@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// tracesFieldPath - URL path for span field APIs.
+	tracesFieldPath = "api/v2/traces/fields"
+)
+
+// GetTracesFields - Returns the selected and interesting span fields.
+func (c *Client) GetTracesFields(ctx context.Context) (*model.TracesFields, error) {
+	url, err := url.JoinPath(c.hostURL.String(), tracesFieldPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fields API responds without the data envelope.
+	var fields model.TracesFields
+	err = json.Unmarshal(body, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "GetTracesFields: span fields fetched", map[string]any{"selected": len(fields.Selected)})
+
+	return &fields, nil
+}
+
+// GetTracesField - Returns the selected span field with the given name and type.
+func (c *Client) GetTracesField(ctx context.Context, name, fieldType string) (*model.TracesField, error) {
+	fields, err := c.GetTracesFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields.Selected {
+		if field.Name == name && field.Type == fieldType {
+			return &field, nil
+		}
+	}
+
+	return nil, fmt.Errorf("span field %s/%s: %w", fieldType, name, ErrNotFound)
+}
+
+// UpdateTracesField - Selects or unselects a span field. Selecting a field
+// materializes it as an indexed column; unselecting drops the column.
+func (c *Client) UpdateTracesField(ctx context.Context, fieldPayload *model.TracesField) error {
+	defer c.locks.Lock("traces/field/" + fieldPayload.Type + "/" + fieldPayload.Name)()
+
+	rb, err := json.Marshal(fieldPayload)
+	if err != nil {
+		return err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), tracesFieldPath)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "UpdateTracesField: span field updated", map[string]any{
+		"name":     fieldPayload.Name,
+		"selected": fieldPayload.Selected,
+	})
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// transcriptFileMode - Permissions of the transcript file when it is created.
+	transcriptFileMode = 0o600
+	// transcriptRedacted - Replaces the redacted headers and fields.
+	transcriptRedacted = "REDACTED"
+)
+
+//nolint:gochecknoglobals
+var (
+	// transcriptSensitiveHeaders - Headers redacted from the transcript.
+	transcriptSensitiveHeaders = []string{SigNozAPIKeyHeader, "Authorization", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+	// transcriptSensitiveFields - Parts of the JSON field names redacted from
+	// the transcript, such as the passwords of SSO domains or the webhook URLs
+	// of channels, which embed their credentials.
+	transcriptSensitiveFields = []string{
+		"password", "secret", "token", "api_key", "apikey", "api_url", "routing_key", "service_key",
+		"webhook_url", "credentials", "private_key",
+	}
+)
+
+// transcriptEntry - Request and response of a single attempt of an API call.
+type transcriptEntry struct {
+	Time       string             `json:"time"`
+	RequestID  string             `json:"requestID"`
+	Method     string             `json:"method"`
+	URL        string             `json:"url"`
+	Request    transcriptMessage  `json:"request"`
+	Response   *transcriptMessage `json:"response,omitempty"`
+	Status     int                `json:"status,omitempty"`
+	Error      string             `json:"error,omitempty"`
+	DurationMS float64            `json:"durationMs"`
+}
+
+// transcriptMessage - Sanitized headers and body of a request or response.
+type transcriptMessage struct {
+	Header map[string][]string `json:"header"`
+	Body   any                 `json:"body,omitempty"`
+}
+
+// transcriptTransport - Appends every request and response, sanitized, as JSON
+// lines to a file, to be attached to bug reports.
+type transcriptTransport struct {
+	next http.RoundTripper
+	file string
+	mu   sync.Mutex
+}
+
+func newTranscriptTransport(next http.RoundTripper, file string) *transcriptTransport {
+	return &transcriptTransport{next: next, file: file}
+}
+
+// RoundTrip - Sends the request and records it along with its response.
+// Failures to write the file are logged only.
+func (t *transcriptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		setRequestBody(req, reqBody)
+		if reqBody, err = decompressRequestBody(req, reqBody); err != nil {
+			return nil, err
+		}
+	}
+
+	entry := transcriptEntry{
+		Time:      time.Now().UTC().Format(time.RFC3339Nano),
+		RequestID: req.Header.Get(RequestIDHeader),
+		Method:    req.Method,
+		URL:       req.URL.String(),
+		Request:   transcriptMessage{Header: sanitizeHeader(req.Header), Body: sanitizeBody(reqBody)},
+	}
+
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	entry.DurationMS = milliseconds(time.Since(start))
+
+	if err != nil {
+		entry.Error = err.Error()
+		t.write(req, entry)
+		return res, err
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+	if err != nil {
+		entry.Error = err.Error()
+		t.write(req, entry)
+		return nil, err
+	}
+
+	entry.Status = res.StatusCode
+	entry.Response = &transcriptMessage{Header: sanitizeHeader(res.Header), Body: sanitizeBody(resBody)}
+	t.write(req, entry)
+
+	return res, nil
+}
+
+// write - Appends the entry to the transcript file.
+func (t *transcriptTransport) write(req *http.Request, entry transcriptEntry) {
+	ctx := req.Context()
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	f, err := os.OpenFile(t.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, transcriptFileMode)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to open the HTTP transcript file", map[string]any{"file": t.file, "error": err.Error()})
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		tflog.Warn(ctx, "Unable to write the HTTP transcript file", map[string]any{"file": t.file, "error": err.Error()})
+	}
+}
+
+// sanitizeHeader - Returns a copy of the header with credentials redacted.
+func sanitizeHeader(header http.Header) map[string][]string {
+	sanitized := make(map[string][]string, len(header))
+	for key, values := range header {
+		sanitized[key] = values
+	}
+	for _, key := range transcriptSensitiveHeaders {
+		if _, ok := sanitized[http.CanonicalHeaderKey(key)]; ok {
+			sanitized[http.CanonicalHeaderKey(key)] = []string{transcriptRedacted}
+		}
+	}
+
+	return sanitized
+}
+
+// sanitizeBody - Returns the body with the sensitive JSON fields redacted. A
+// body which is not JSON is returned as a string.
+func sanitizeBody(body []byte) any {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	return sanitizeValue(value)
+}
+
+// sanitizeValue - Redacts the sensitive fields of the JSON value, at any depth.
+// JSON encoded in strings, such as the receivers of the channels, is redacted
+// as well.
+func sanitizeValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if isSensitiveField(key) && field != nil && field != "" {
+				v[key] = transcriptRedacted
+				continue
+			}
+			v[key] = sanitizeValue(field)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = sanitizeValue(item)
+		}
+	case string:
+		trimmed := strings.TrimSpace(v)
+		if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+			return v
+		}
+		var nested any
+		if err := json.Unmarshal([]byte(trimmed), &nested); err != nil {
+			return v
+		}
+		sanitized, err := json.Marshal(sanitizeValue(nested))
+		if err != nil {
+			return v
+		}
+		return string(sanitized)
+	}
+
+	return value
+}
+
+// isSensitiveField - Returns true if the JSON field may hold a credential.
+func isSensitiveField(key string) bool {
+	lower := strings.ToLower(key)
+	for _, field := range transcriptSensitiveFields {
+		if strings.Contains(lower, field) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -18,6 +18,16 @@ type alertResponse struct {
 	Data      model.Alert `json:"data"`
 }
 
+// alertListResponse - Maps the response data of ListAlerts.
+type alertListResponse struct {
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+	ErrorType string `json:"errorType"`
+	Data      struct {
+		Rules []model.Alert `json:"rules"`
+	} `json:"data"`
+}
+
 // dashboardRespose - Maps the response data of CreateDashboard and GetDashboard.
 type dashboardResponse struct {
 	Status    string        `json:"status"`
@@ -30,8 +40,128 @@ type dashboardData struct {
 	CreatedAt string          `json:"createdAt"`
 	CreatedBy string          `json:"createdBy"`
 	ID        string          `json:"id"`
+	UUID      string          `json:"uuid,omitempty"`
 	Locked    bool            `json:"locked"`
 	UpdatedAt string          `json:"updatedAt"`
 	UpdatedBy string          `json:"updatedBy"`
 	Data      model.Dashboard `json:"data"`
 }
+
+// Key - Returns the identifier of the dashboard in the dashboard APIs. Older
+// SigNoz versions address dashboards by a UUID next to a numeric ID.
+func (d dashboardData) Key() string {
+	if d.UUID != "" {
+		return d.UUID
+	}
+
+	return d.ID
+}
+
+// NumericID - Returns the numeric ID of the dashboard returned next to its UUID
+// by older SigNoz versions, or an empty string otherwise.
+func (d dashboardData) NumericID() string {
+	if d.UUID == "" {
+		return ""
+	}
+
+	return d.ID
+}
+
+// dashboardListResponse - Maps the response data of ListDashboards.
+type dashboardListResponse struct {
+	Status    string          `json:"status"`
+	Error     string          `json:"error,omitempty"`
+	ErrorType string          `json:"errorType,omitempty"`
+	Data      []dashboardData `json:"data"`
+}
+
+// licenseResponse - Maps the response data of ApplyLicense and GetActiveLicense.
+type licenseResponse struct {
+	Status    string        `json:"status"`
+	Error     string        `json:"error,omitempty"`
+	ErrorType string        `json:"errorType,omitempty"`
+	Data      model.License `json:"data"`
+}
+
+// channelResponse - Maps the response data of GetChannel.
+type channelResponse struct {
+	Status    string        `json:"status"`
+	Error     string        `json:"error,omitempty"`
+	ErrorType string        `json:"errorType,omitempty"`
+	Data      model.Channel `json:"data"`
+}
+
+// channelListResponse - Maps the response data of ListChannels.
+type channelListResponse struct {
+	Status    string          `json:"status"`
+	Error     string          `json:"error,omitempty"`
+	ErrorType string          `json:"errorType,omitempty"`
+	Data      []model.Channel `json:"data"`
+}
+
+// downtimeResponse - Maps the response data of GetDowntime and CreateDowntime.
+type downtimeResponse struct {
+	Status    string                `json:"status"`
+	Error     string                `json:"error,omitempty"`
+	ErrorType string                `json:"errorType,omitempty"`
+	Data      model.PlannedDowntime `json:"data"`
+}
+
+// downtimeListResponse - Maps the response data of ListDowntimes.
+type downtimeListResponse struct {
+	Status    string                  `json:"status"`
+	Error     string                  `json:"error,omitempty"`
+	ErrorType string                  `json:"errorType,omitempty"`
+	Data      []model.PlannedDowntime `json:"data"`
+}
+
+// domainResponse - Maps the response data of CreateDomain and UpdateDomain.
+type domainResponse struct {
+	Status    string       `json:"status"`
+	Error     string       `json:"error,omitempty"`
+	ErrorType string       `json:"errorType,omitempty"`
+	Data      model.Domain `json:"data"`
+}
+
+// domainListResponse - Maps the response data of ListDomains.
+type domainListResponse struct {
+	Status    string         `json:"status"`
+	Error     string         `json:"error,omitempty"`
+	ErrorType string         `json:"errorType,omitempty"`
+	Data      []model.Domain `json:"data"`
+}
+
+// pipelinesResponse - Maps the response data of GetPipelines and savePipelines.
+type pipelinesResponse struct {
+	Status    string          `json:"status"`
+	Error     string          `json:"error,omitempty"`
+	ErrorType string          `json:"errorType,omitempty"`
+	Data      model.Pipelines `json:"data"`
+}
+
+// userListResponse - Maps the response data of ListUsers.
+type userListResponse struct {
+	Status    string       `json:"status"`
+	Error     string       `json:"error"`
+	ErrorType string       `json:"errorType"`
+	Data      []model.User `json:"data"`
+}
+
+// metricMetadataResponse - Maps the response data of GetMetricMetadata. The
+// metadata is nested in the details of the metric, with other field names
+// than the update payload.
+type metricMetadataResponse struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	ErrorType string `json:"errorType,omitempty"`
+	Data      struct {
+		Name     string `json:"name"`
+		Metadata *struct {
+			Description string `json:"description"`
+			Type        string `json:"type"`
+			Unit        string `json:"unit"`
+			Temporality string `json:"temporality"`
+			Monotonic   bool   `json:"monotonic"`
+		} `json:"metadata"`
+	} `json:"data"`
+}
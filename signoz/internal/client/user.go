@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// userRolePath - URL path for user role APIs.
+	userRolePath = "api/v1/rbac/role"
+	// userPath - URL path for user APIs.
+	userPath = "api/v1/user"
+)
+
+// ListUsers - Returns the users of the organization.
+func (c *Client) ListUsers(ctx context.Context) ([]model.User, error) {
+	url, err := url.JoinPath(c.hostURL.String(), userPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Older SigNoz versions answer with the bare list instead of the status
+	// envelope.
+	var users []model.User
+	if err := json.Unmarshal(body, &users); err != nil {
+		var bodyObj userListResponse
+		if err := json.Unmarshal(body, &bodyObj); err != nil {
+			return nil, err
+		}
+		if bodyObj.Status != "success" || bodyObj.Error != "" {
+			tflog.Error(ctx, "ListUsers: error while listing users", map[string]any{
+				"error":     bodyObj.Error,
+				"errorType": bodyObj.ErrorType,
+			})
+			return nil, fmt.Errorf("error while listing users: %s", bodyObj.Error)
+		}
+		users = bodyObj.Data
+	}
+
+	tflog.Debug(ctx, "ListUsers: users listed", map[string]any{"count": len(users)})
+
+	return users, nil
+}
+
+// GetUserRole - Returns the role of a user.
+func (c *Client) GetUserRole(ctx context.Context, userID string) (*model.UserRole, error) {
+	url, err := url.JoinPath(c.hostURL.String(), userRolePath, userID)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The role API answers with the bare object instead of the status envelope.
+	var userRole model.UserRole
+	err = json.Unmarshal(body, &userRole)
+	if err != nil {
+		return nil, err
+	}
+	userRole.UserID = userID
+
+	tflog.Debug(ctx, "GetUserRole: role fetched", map[string]any{"userID": userID, "role": userRole.GroupName})
+
+	return &userRole, nil
+}
+
+// UpdateUserRole - Assigns a role to a user.
+func (c *Client) UpdateUserRole(ctx context.Context, userID, role string) error {
+	defer c.locks.Lock("user/" + userID)()
+
+	rb, err := json.Marshal(model.UserRole{GroupName: role})
+	if err != nil {
+		return err
+	}
+
+	url, err := url.JoinPath(c.hostURL.String(), userRolePath, userID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "UpdateUserRole: role updated", map[string]any{"userID": userID, "role": role})
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// versionPath - URL path for the version API.
+	versionPath = "api/v1/version"
+
+	// versionProbeTimeout - Upper bound for detecting the server version.
+	versionProbeTimeout = 5 * time.Second
+
+	// defaultAlertPayloadVersion - Alert payload version used when the server
+	// version cannot be detected.
+	defaultAlertPayloadVersion = "v4"
+)
+
+// ServerVersion - Version of the SigNoz server the client talks to.
+type ServerVersion struct {
+	Raw   string
+	Major int
+	Minor int
+	Patch int
+	EE    bool
+}
+
+// AtLeast - Returns true if the server version is greater than or equal to major.minor.patch.
+func (v ServerVersion) AtLeast(major, minor, patch int) bool {
+	if v.Major != major {
+		return v.Major > major
+	}
+	if v.Minor != minor {
+		return v.Minor > minor
+	}
+
+	return v.Patch >= patch
+}
+
+// String - Returns the version as reported by the server.
+func (v ServerVersion) String() string {
+	return v.Raw
+}
+
+// alertPayloadVersions - Alert payload versions ordered from the newest to the
+// oldest server release that accepts them. All listed releases serve rules
+// under alertPath, so only the payload version varies.
+//
+// v0.38.0 shipped the v4 metrics query builder (timeAggregation and
+// spaceAggregation); older servers only understand v3 rule payloads. Newer
+// payload versions are not listed because the provider only converts the
+// user's condition JSON to the version set on the alert; they are detected
+// from the shape of the condition instead, which takes precedence over the
+// server version.
+//
+//nolint:gochecknoglobals
+var alertPayloadVersions = []struct {
+	major, minor, patch int
+	payloadVersion      string
+}{
+	{major: 0, minor: 38, patch: 0, payloadVersion: "v4"},
+	{major: 0, minor: 0, patch: 0, payloadVersion: "v3"},
+}
+
+// alertThresholdsVersion - First server release accepting the thresholds of the
+// v2alpha1 rule schema. Older servers only understand the single target of
+// legacy conditions.
+//
+//nolint:gochecknoglobals
+var alertThresholdsVersion = ServerVersion{Raw: "v0.96.0", Major: 0, Minor: 96, Patch: 0}
+
+// versionResponse - Maps the response data of the version API.
+type versionResponse struct {
+	Version string `json:"version"`
+	EE      string `json:"ee"`
+}
+
+// GetVersion - Returns the version of the SigNoz server. The request is sent
+// once without retries.
+func (c *Client) GetVersion(ctx context.Context) (*ServerVersion, error) {
+	url, err := url.JoinPath(c.hostURL.String(), versionPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.send(ctx, c.probeClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyObj versionResponse
+	err = json.Unmarshal(body, &bodyObj)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := parseServerVersion(bodyObj.Version)
+	if err != nil {
+		return nil, err
+	}
+	version.EE = bodyObj.EE == "Y"
+
+	tflog.Debug(ctx, "GetVersion: version fetched", map[string]any{"version": version.Raw, "ee": version.EE})
+
+	return version, nil
+}
+
+// NegotiateAPIVersion - Detects the server version and selects the matching
+// alert payload version and condition shape. The detection is bounded by versionProbeTimeout; on
+// failure the client keeps the default payload version and the error is
+// returned so the caller can report the fallback. Mock clients keep the
+// default payload version.
+func (c *Client) NegotiateAPIVersion(ctx context.Context) error {
+	if c.mock {
+		return nil
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, versionProbeTimeout)
+	defer cancel()
+
+	version, err := c.GetVersion(probeCtx)
+	if err != nil {
+		return fmt.Errorf("unable to detect SigNoz version, using alert payload version %s: %w", c.alertPayloadVersion, err)
+	}
+
+	c.alertPayloadVersion = selectAlertPayloadVersion(*version)
+	c.alertThresholds = version.AtLeast(alertThresholdsVersion.Major, alertThresholdsVersion.Minor, alertThresholdsVersion.Patch)
+
+	tflog.Info(ctx, "NegotiateAPIVersion: selected alert payload version", map[string]any{
+		"version":        version.Raw,
+		"payloadVersion": c.alertPayloadVersion,
+		"thresholds":     c.alertThresholds,
+	})
+
+	return nil
+}
+
+// selectAlertPayloadVersion - Returns the newest alert payload version accepted by the server.
+func selectAlertPayloadVersion(version ServerVersion) string {
+	for _, candidate := range alertPayloadVersions {
+		if version.AtLeast(candidate.major, candidate.minor, candidate.patch) {
+			return candidate.payloadVersion
+		}
+	}
+
+	return defaultAlertPayloadVersion
+}
+
+// parseServerVersion - Parses versions of the form v0.55.0 or 0.55.0-rc.1.
+func parseServerVersion(raw string) (*ServerVersion, error) {
+	trimmed := strings.TrimPrefix(strings.TrimSpace(raw), "v")
+	if idx := strings.IndexAny(trimmed, "-+"); idx >= 0 {
+		trimmed = trimmed[:idx]
+	}
+
+	parts := strings.Split(trimmed, ".")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid SigNoz version: %q", raw)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return nil, fmt.Errorf("invalid SigNoz version: %q", raw)
+		}
+		numbers[i] = number
+	}
+
+	return &ServerVersion{
+		Raw:   raw,
+		Major: numbers[0],
+		Minor: numbers[1],
+		Patch: numbers[2],
+	}, nil
+}
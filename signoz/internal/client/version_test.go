@@ -0,0 +1,81 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	cases := []struct {
+		raw     string
+		want    ServerVersion
+		wantErr bool
+	}{
+		{raw: "v0.55.0", want: ServerVersion{Raw: "v0.55.0", Major: 0, Minor: 55, Patch: 0}},
+		{raw: "0.55.0-rc.1", want: ServerVersion{Raw: "0.55.0-rc.1", Major: 0, Minor: 55, Patch: 0}},
+		{raw: "0.55", want: ServerVersion{Raw: "0.55", Major: 0, Minor: 55, Patch: 0}},
+		{raw: "v1.2.3+build", want: ServerVersion{Raw: "v1.2.3+build", Major: 1, Minor: 2, Patch: 3}},
+		{raw: "", wantErr: true},
+		{raw: "1.2.3.4", wantErr: true},
+		{raw: "latest", wantErr: true},
+		{raw: "v0.-1.0", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.raw, func(t *testing.T) {
+			got, err := parseServerVersion(tc.raw)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *got != tc.want {
+				t.Fatalf("got %+v, want %+v", *got, tc.want)
+			}
+		})
+	}
+}
+
+func TestServerVersionAtLeast(t *testing.T) {
+	version := ServerVersion{Major: 0, Minor: 38, Patch: 2}
+
+	cases := []struct {
+		major, minor, patch int
+		want                bool
+	}{
+		{0, 38, 2, true},
+		{0, 38, 1, true},
+		{0, 38, 3, false},
+		{0, 37, 9, true},
+		{0, 39, 0, false},
+		{1, 0, 0, false},
+		{0, 0, 0, true},
+	}
+
+	for _, tc := range cases {
+		if got := version.AtLeast(tc.major, tc.minor, tc.patch); got != tc.want {
+			t.Errorf("AtLeast(%d, %d, %d) = %t, want %t", tc.major, tc.minor, tc.patch, got, tc.want)
+		}
+	}
+}
+
+func TestSelectAlertPayloadVersion(t *testing.T) {
+	cases := []struct {
+		version ServerVersion
+		want    string
+	}{
+		{ServerVersion{Major: 0, Minor: 37, Patch: 9}, "v3"},
+		{ServerVersion{Major: 0, Minor: 38, Patch: 0}, "v4"},
+		{ServerVersion{Major: 0, Minor: 90, Patch: 1}, "v4"},
+		{ServerVersion{Major: 1, Minor: 0, Patch: 0}, "v4"},
+	}
+
+	for _, tc := range cases {
+		if got := selectAlertPayloadVersion(tc.version); got != tc.want {
+			t.Errorf("selectAlertPayloadVersion(%+v) = %s, want %s", tc.version, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = DurationType{}
+	_ basetypes.StringValuableWithSemanticEquals = Duration{}
+)
+
+// NormalizeDuration - Returns the duration in the canonical format used by
+// SigNoz, e.g. 5m becomes 5m0s and 1h becomes 1h0m0s.
+func NormalizeDuration(value string) (string, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return "", err
+	}
+
+	return duration.String(), nil
+}
+
+// DurationType - String type of durations such as 5m0s.
+type DurationType struct {
+	basetypes.StringType
+}
+
+// String - Returns a human readable string of the type name.
+func (t DurationType) String() string {
+	return "customtypes.DurationType"
+}
+
+// ValueType - Returns the value type of this type.
+func (t DurationType) ValueType(_ context.Context) attr.Value {
+	return Duration{}
+}
+
+// Equal - Returns true if the given type is equivalent.
+func (t DurationType) Equal(o attr.Type) bool {
+	other, ok := o.(DurationType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString - Returns a Duration for the given string value.
+func (t DurationType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Duration{StringValue: in}, nil
+}
+
+// ValueFromTerraform - Returns a Duration for the given Terraform value.
+func (t DurationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return Duration{StringValue: stringValue}, nil
+}
+
+// Duration - Duration value which is semantically equal to any other
+// representation of the same length of time, so 5m and 5m0s never drift.
+type Duration struct {
+	basetypes.StringValue
+}
+
+// NewDurationValue - Returns a known Duration.
+func NewDurationValue(value string) Duration {
+	return Duration{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewDurationNull - Returns a null Duration.
+func NewDurationNull() Duration {
+	return Duration{StringValue: basetypes.NewStringNull()}
+}
+
+// Type - Returns the type of the value.
+func (v Duration) Type(_ context.Context) attr.Type {
+	return DurationType{}
+}
+
+// Equal - Returns true if the given value is the exact same duration string.
+func (v Duration) Equal(o attr.Value) bool {
+	other, ok := o.(Duration)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals - Returns true if both values are the same length of time.
+func (v Duration) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Duration)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this issue to the provider developers.", v, newValuable))
+		return false, diags
+	}
+
+	oldDuration, err := time.ParseDuration(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newDuration, err := time.ParseDuration(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return oldDuration == newDuration, diags
+}
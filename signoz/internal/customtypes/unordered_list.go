@@ -0,0 +1,125 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.ListTypable                    = UnorderedListType{}
+	_ basetypes.ListValuableWithSemanticEquals = UnorderedList{}
+)
+
+// UnorderedListType - List type whose values are equal regardless of the order
+// of their elements, such as tags reordered by the SigNoz UI.
+type UnorderedListType struct {
+	basetypes.ListType
+}
+
+// NewUnorderedListType - Returns an UnorderedListType of the element type.
+func NewUnorderedListType(elemType attr.Type) UnorderedListType {
+	return UnorderedListType{ListType: basetypes.ListType{ElemType: elemType}}
+}
+
+// String - Returns a human readable string of the type name.
+func (t UnorderedListType) String() string {
+	return "customtypes.UnorderedListType[" + t.ElementType().String() + "]"
+}
+
+// ValueType - Returns the value type of this type.
+func (t UnorderedListType) ValueType(_ context.Context) attr.Value {
+	return UnorderedList{ListValue: basetypes.NewListNull(t.ElementType())}
+}
+
+// Equal - Returns true if the given type is equivalent.
+func (t UnorderedListType) Equal(o attr.Type) bool {
+	other, ok := o.(UnorderedListType)
+	if !ok {
+		return false
+	}
+
+	return t.ListType.Equal(other.ListType)
+}
+
+// ValueFromList - Returns an UnorderedList for the given list value.
+func (t UnorderedListType) ValueFromList(_ context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return UnorderedList{ListValue: in}, nil
+}
+
+// ValueFromTerraform - Returns an UnorderedList for the given Terraform value.
+func (t UnorderedListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := attrValue.(basetypes.ListValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return UnorderedList{ListValue: listValue}, nil
+}
+
+// UnorderedList - List value which is semantically equal to any other list of
+// the same elements, in any order.
+type UnorderedList struct {
+	basetypes.ListValue
+}
+
+// NewUnorderedList - Returns an UnorderedList of the list value.
+func NewUnorderedList(value basetypes.ListValue) UnorderedList {
+	return UnorderedList{ListValue: value}
+}
+
+// Type - Returns the type of the value.
+func (v UnorderedList) Type(ctx context.Context) attr.Type {
+	return NewUnorderedListType(v.ElementType(ctx))
+}
+
+// Equal - Returns true if the given value is the exact same list.
+func (v UnorderedList) Equal(o attr.Value) bool {
+	other, ok := o.(UnorderedList)
+	if !ok {
+		return false
+	}
+
+	return v.ListValue.Equal(other.ListValue)
+}
+
+// ListSemanticEquals - Returns true if both lists hold the same elements, as
+// many times each, in any order.
+func (v UnorderedList) ListSemanticEquals(_ context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UnorderedList)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this issue to the provider developers.", v, newValuable))
+		return false, diags
+	}
+
+	oldElements, newElements := v.Elements(), newValue.Elements()
+	if len(oldElements) != len(newElements) {
+		return false, diags
+	}
+
+	counts := map[string]int{}
+	for _, element := range oldElements {
+		counts[element.String()]++
+	}
+	for _, element := range newElements {
+		counts[element.String()]--
+		if counts[element.String()] < 0 {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
@@ -8,7 +10,6 @@ import (
 	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
 )
 
 const (
@@ -31,6 +32,16 @@ const (
 	AlertStateDisabled = "disabled"
 
 	AlertTerraformLabel = "managedBy:terraform"
+
+	// AlertSetLabel - Label holding the name of the alert set an alert belongs
+	// to.
+	AlertSetLabel = "alertSet"
+
+	// AlertSchemaVersionV2Alpha1 - Rule schema version supporting multiple
+	// thresholds and notification settings.
+	AlertSchemaVersionV2Alpha1 = "v2alpha1"
+
+	alertThresholdsKindBasic = "basic"
 )
 
 //nolint:gochecknoglobals
@@ -43,25 +54,59 @@ var (
 
 // Alert model.
 type Alert struct {
-	ID                string                 `json:"id"`
-	Alert             string                 `json:"alert"`
-	AlertType         string                 `json:"alertType"`
-	Annotations       AlertAnnotations       `json:"annotations"`
-	BroadcastToAll    bool                   `json:"broadcastToAll"`
-	Condition         map[string]interface{} `json:"condition"`
-	Disabled          bool                   `json:"disabled,omitempty"`
-	EvalWindow        string                 `json:"evalWindow"`
-	Frequency         string                 `json:"frequency"`
-	Labels            map[string]string      `json:"labels"`
-	PreferredChannels []string               `json:"preferredChannels"`
-	RuleType          string                 `json:"ruleType"`
-	Source            string                 `json:"source"`
-	State             string                 `json:"state,omitempty"`
-	Version           string                 `json:"version"`
-	CreateAt          string                 `json:"createAt,omitempty"`
-	CreateBy          string                 `json:"createBy,omitempty"`
-	UpdateAt          string                 `json:"updateAt,omitempty"`
-	UpdateBy          string                 `json:"updateBy,omitempty"`
+	ID                   string                     `json:"id"`
+	Alert                string                     `json:"alert"`
+	AlertType            string                     `json:"alertType"`
+	Annotations          AlertAnnotations           `json:"annotations"`
+	BroadcastToAll       bool                       `json:"broadcastToAll"`
+	Condition            *Condition                 `json:"condition"`
+	Disabled             bool                       `json:"disabled,omitempty"`
+	EvalWindow           string                     `json:"evalWindow"`
+	Frequency            string                     `json:"frequency"`
+	Labels               map[string]string          `json:"labels"`
+	PreferredChannels    []string                   `json:"preferredChannels"`
+	RuleType             string                     `json:"ruleType"`
+	Source               string                     `json:"source"`
+	State                string                     `json:"state,omitempty"`
+	Version              string                     `json:"version"`
+	SchemaVersion        string                     `json:"schemaVersion,omitempty"`
+	NotificationSettings *AlertNotificationSettings `json:"notificationSettings,omitempty"`
+	CreateAt             string                     `json:"createAt,omitempty"`
+	CreateBy             string                     `json:"createBy,omitempty"`
+	UpdateAt             string                     `json:"updateAt,omitempty"`
+	UpdateBy             string                     `json:"updateBy,omitempty"`
+}
+
+// AlertThreshold - Threshold of a rule with multiple thresholds, named after
+// its severity. Op and MatchType hold API values.
+type AlertThreshold struct {
+	Name           string   `json:"name"`
+	Target         float64  `json:"target"`
+	TargetUnit     string   `json:"targetUnit"`
+	RecoveryTarget *float64 `json:"recoveryTarget"`
+	MatchType      string   `json:"matchType"`
+	Op             string   `json:"op"`
+	Channels       []string `json:"channels"`
+}
+
+// AlertNotificationSettings - Grouping and repetition of the notifications of
+// a rule.
+type AlertNotificationSettings struct {
+	GroupBy  []string       `json:"groupBy,omitempty"`
+	Renotify *AlertRenotify `json:"renotify,omitempty"`
+}
+
+// AlertRenotify - Repetition of the notifications of a rule in the states.
+type AlertRenotify struct {
+	Enabled     bool     `json:"enabled"`
+	Interval    string   `json:"interval,omitempty"`
+	AlertStates []string `json:"alertStates,omitempty"`
+}
+
+// alertThresholds - Thresholds of the condition.
+type alertThresholds struct {
+	Kind string           `json:"kind"`
+	Spec []AlertThreshold `json:"spec"`
 }
 
 // Alert Annotations model.
@@ -83,19 +128,182 @@ func (a Alert) GetType() string {
 }
 
 func (a Alert) ConditionToTerraform() (types.String, error) {
-	condition, err := structure.FlattenJsonToString(a.Condition)
+	if len(a.Condition.Map()) == 0 {
+		return types.StringValue(""), nil
+	}
+
+	condition, err := json.Marshal(a.Condition)
 	if err != nil {
 		return types.StringValue(""), err
 	}
 
-	return types.StringValue(condition), nil
+	return types.StringValue(string(condition)), nil
+}
+
+// HasThresholds - Returns true if the condition holds multiple thresholds.
+func (a Alert) HasThresholds() bool {
+	_, ok := a.ConditionValue(ConditionKeyThresholds)
+	return ok
+}
+
+// Thresholds - Returns the thresholds of the condition, if any. A condition
+// of the legacy shape, as stored by servers which do not support thresholds,
+// returns its single target as a threshold named after the severity label.
+func (a Alert) Thresholds() ([]AlertThreshold, error) {
+	value, ok := a.ConditionValue(ConditionKeyThresholds)
+	if !ok {
+		return a.legacyThreshold(), nil
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var thresholds alertThresholds
+	if err := json.Unmarshal(b, &thresholds); err != nil {
+		return nil, fmt.Errorf("invalid alert thresholds: %w", err)
+	}
+
+	return thresholds.Spec, nil
+}
+
+// legacyThreshold - Returns the single target of the condition as a threshold,
+// or nil if the condition has no target.
+func (a Alert) legacyThreshold() []AlertThreshold {
+	if a.Condition == nil || a.Condition.Target == nil {
+		return nil
+	}
+
+	return []AlertThreshold{{
+		Name:       a.Labels[attr.Severity],
+		Target:     *a.Condition.Target,
+		TargetUnit: stringValue(a.Condition.TargetUnit),
+		MatchType:  stringValue(a.Condition.MatchType),
+		Op:         stringValue(a.Condition.Op),
+		Channels:   a.PreferredChannels,
+	}}
+}
+
+// ConditionQueries - Returns the ClickHouse or PromQL queries of the condition
+// under the key, ConditionKeyCHQueries or ConditionKeyPromQueries, by name.
+func (a Alert) ConditionQueries(key string) (map[string]ConditionQuery, error) {
+	value, ok := a.ConditionValue(key)
+	if !ok {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var queries map[string]ConditionQuery
+	if err := json.Unmarshal(b, &queries); err != nil {
+		return nil, fmt.Errorf("invalid alert queries: %w", err)
+	}
+
+	return queries, nil
+}
+
+// SetConditionQueries - Sets the ClickHouse or PromQL queries of the condition
+// under the key, ConditionKeyCHQueries or ConditionKeyPromQueries, naming each
+// query after its key.
+func (a *Alert) SetConditionQueries(key string, queries map[string]ConditionQuery) {
+	if a.Condition == nil {
+		a.Condition = &Condition{}
+	}
+	if a.Condition.CompositeQuery == nil {
+		a.Condition.CompositeQuery = &CompositeQuery{}
+	}
+	compositeQuery := a.Condition.CompositeQuery
+
+	switch key {
+	case ConditionKeyCHQueries:
+		compositeQuery.ChQueries = make(map[string]*ChQuery, len(queries))
+		for name, query := range queries {
+			compositeQuery.ChQueries[name] = &ChQuery{
+				Name:     utils.Ptr(name),
+				Query:    utils.Ptr(query.Query),
+				Legend:   utils.Ptr(query.Legend),
+				Disabled: utils.Ptr(query.Disabled),
+			}
+		}
+	case ConditionKeyPromQueries:
+		compositeQuery.PromQueries = make(map[string]*PromQuery, len(queries))
+		for name, query := range queries {
+			compositeQuery.PromQueries[name] = &PromQuery{
+				Name:     utils.Ptr(name),
+				Query:    utils.Ptr(query.Query),
+				Legend:   utils.Ptr(query.Legend),
+				Disabled: utils.Ptr(query.Disabled),
+			}
+		}
+	}
+}
+
+// ConditionValue - Returns the value of the condition key. A dotted key such
+// as compositeQuery.chQueries is looked up in the nested object.
+func (a Alert) ConditionValue(key string) (interface{}, bool) {
+	condition := a.Condition.Map()
+	if parent, child, ok := strings.Cut(key, "."); ok {
+		if condition, ok = condition[parent].(map[string]interface{}); !ok {
+			return nil, false
+		}
+		key = child
+	}
+
+	value, ok := condition[key]
+	return value, ok
 }
 
-func (a Alert) LabelsToTerraform() (types.Map, diag.Diagnostics) {
+// ConditionWithout - Returns a copy of the condition without the keys, such
+// as the ones managed through their own attribute. A dotted key removes the
+// key from the nested object.
+func (a Alert) ConditionWithout(keys ...string) *Condition {
+	if a.Condition == nil {
+		return nil
+	}
+
+	condition := a.Condition.Map()
+	for _, key := range keys {
+		if parent, child, ok := strings.Cut(key, "."); ok {
+			if nested, ok := condition[parent].(map[string]interface{}); ok {
+				delete(nested, child)
+			}
+			continue
+		}
+		delete(condition, key)
+	}
+
+	b, err := json.Marshal(condition)
+	if err != nil {
+		return a.Condition
+	}
+	var without Condition
+	if err := json.Unmarshal(b, &without); err != nil {
+		return a.Condition
+	}
+
+	return &without
+}
+
+// ParseAlertLabel - Parses a label of the form key:value.
+func ParseAlertLabel(label string) (string, string, error) {
+	key, value, ok := strings.Cut(label, ":")
+	key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+	if !ok || key == "" || value == "" {
+		return "", "", fmt.Errorf("invalid alert label %q, expected the form key:value", label)
+	}
+
+	return key, value, nil
+}
+
+// LabelsToTerraform - Returns the labels of the alert, except for the labels
+// managed by the provider. The severity label, which is exposed through its own
+// attribute, is only included if withSeverity is true.
+func (a Alert) LabelsToTerraform(managedLabels map[string]string, withSeverity bool) (types.Map, diag.Diagnostics) {
 	elements := map[string]tfattr.Value{}
-	terraformLabels := strings.Split(AlertTerraformLabel, ":")
 	for key, value := range a.Labels {
-		if key == attr.Severity || key == terraformLabels[0] {
+		if _, managed := managedLabels[key]; managed || (key == attr.Severity && !withSeverity) {
 			continue
 		}
 		elements[key] = types.StringValue(value)
@@ -139,24 +347,96 @@ func (a Alert) ToTerraform() interface{} {
 }
 
 func (a *Alert) SetCondition(tfCondition types.String) error {
-	condition, err := structure.ExpandJsonFromString(tfCondition.ValueString())
-	if err != nil {
+	var condition Condition
+	if err := json.Unmarshal([]byte(tfCondition.ValueString()), &condition); err != nil {
 		return err
 	}
 
-	a.Condition = condition
+	a.Condition = &condition
+	return nil
+}
+
+// SetThresholds - Sets the thresholds of the condition and the schema version
+// supporting them. Nothing is set if there are no thresholds.
+func (a *Alert) SetThresholds(thresholds []AlertThreshold) {
+	if len(thresholds) == 0 {
+		return
+	}
+	if a.Condition == nil {
+		a.Condition = &Condition{}
+	}
+
+	// The thresholds are converted through JSON, so that every field is sent,
+	// including a null recoveryTarget.
+	b, err := json.Marshal(alertThresholds{Kind: alertThresholdsKindBasic, Spec: thresholds})
+	if err != nil {
+		return
+	}
+	a.Condition.Thresholds = &ConditionThresholds{}
+	if err := json.Unmarshal(b, a.Condition.Thresholds); err != nil {
+		return
+	}
+	a.SchemaVersion = AlertSchemaVersionV2Alpha1
+}
+
+// SetLegacyThreshold - Replaces the thresholds of the condition with the
+// single target of the legacy shape, for servers which do not support
+// thresholds. The channels of the threshold become the preferred channels,
+// unless set. It fails if there are several thresholds.
+func (a *Alert) SetLegacyThreshold() error {
+	if a.Condition == nil || a.Condition.Thresholds == nil {
+		return nil
+	}
+
+	spec := a.Condition.Thresholds.Spec
+	if len(spec) > 1 {
+		return fmt.Errorf("%d thresholds cannot be expressed as the single target of a legacy condition", len(spec))
+	}
+	if len(spec) == 1 && spec[0] != nil {
+		threshold := spec[0]
+		a.Condition.Target = threshold.Target
+		a.Condition.TargetUnit = threshold.TargetUnit
+		a.Condition.MatchType = threshold.MatchType
+		a.Condition.Op = threshold.Op
+		if len(a.PreferredChannels) == 0 {
+			a.PreferredChannels = threshold.Channels
+		}
+	}
+
+	a.Condition.Thresholds = nil
+	if a.NotificationSettings == nil {
+		a.SchemaVersion = ""
+	}
+
 	return nil
 }
 
-func (a *Alert) SetLabels(tfLabels types.Map, tfSeverity types.String) {
+// SetNotificationSettings - Sets the notification settings of the alert and
+// the schema version supporting them. Nothing is set if settings is nil.
+func (a *Alert) SetNotificationSettings(settings *AlertNotificationSettings) {
+	if settings == nil {
+		return
+	}
+
+	a.NotificationSettings = settings
+	a.SchemaVersion = AlertSchemaVersionV2Alpha1
+}
+
+// SetLabels - Sets the labels of the alert along with the severity and the
+// labels managed by the provider.
+func (a *Alert) SetLabels(tfLabels types.Map, tfSeverity types.String, managedLabels map[string]string) {
 	labels := make(map[string]string)
 
+	// The values are taken as they are, as they may hold templates with quotes.
 	for key, value := range tfLabels.Elements() {
-		labels[key] = strings.Trim(value.String(), "\"")
+		if value, ok := value.(types.String); ok {
+			labels[key] = value.ValueString()
+		}
 	}
 
-	terraformLabel := strings.Split(AlertTerraformLabel, ":")
-	labels[strings.TrimSpace(terraformLabel[0])] = strings.TrimSpace(terraformLabel[1])
+	for key, value := range managedLabels {
+		labels[key] = value
+	}
 
 	if tfSeverity.ValueString() != "" {
 		labels[attr.Severity] = tfSeverity.ValueString()
@@ -175,3 +455,8 @@ func (a *Alert) SetPreferredChannels(tfPreferredChannels types.List) {
 func (a *Alert) SetSourceIfEmpty(hostURL string) {
 	a.Source = utils.WithDefault(a.Source, hostURL+"/alerts")
 }
+
+// SetVersionIfEmpty - Set the payload version if it is not set.
+func (a *Alert) SetVersionIfEmpty(version string) {
+	a.Version = utils.WithDefault(a.Version, version)
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Channel model. A notification channel holds an Alertmanager receiver, stored
+// as a JSON string.
+type Channel struct {
+	ID   ObjectID `json:"id"`
+	Name string   `json:"name"`
+	Type string   `json:"type"`
+	Data string   `json:"data"`
+}
+
+// ReceiverName - Returns the name of the Alertmanager receiver JSON, which
+// names the channel.
+func ReceiverName(receiver string) (string, error) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(receiver), &fields); err != nil {
+		return "", err
+	}
+
+	name, _ := fields["name"].(string)
+	if name == "" {
+		return "", errors.New("the receiver has no name")
+	}
+
+	return name, nil
+}
@@ -0,0 +1,330 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// CollectorProcessorAttributes - Collector processor inserting, updating and deleting attributes.
+	CollectorProcessorAttributes = "attributes"
+	// CollectorProcessorLogsTransform - Collector processor running stanza operators.
+	CollectorProcessorLogsTransform = "logstransform"
+	// CollectorProcessorTransform - Collector processor running OTTL statements.
+	CollectorProcessorTransform = "transform"
+)
+
+//nolint:gochecknoglobals
+var (
+	// CollectorProcessors are the collector processors which can be converted.
+	CollectorProcessors = []string{CollectorProcessorAttributes, CollectorProcessorLogsTransform, CollectorProcessorTransform}
+
+	// ottlPath matches an OTTL path of the log, such as attributes["key"].
+	ottlPath = `(body|attributes\["[^"]+"\]|resource\.attributes\["[^"]+"\])`
+	// ottlString matches an OTTL string literal.
+	ottlString = `"((?:[^"\\]|\\.)*)"`
+
+	ottlSetLiteral = regexp.MustCompile(`^set\(` + ottlPath + `,\s*` + ottlString + `\)$`)
+	ottlSetPath    = regexp.MustCompile(`^set\(` + ottlPath + `,\s*` + ottlPath + `\)$`)
+	ottlDeleteKey  = regexp.MustCompile(`^delete_key\((attributes|resource\.attributes),\s*` + ottlString + `\)$`)
+	ottlParseJSON  = regexp.MustCompile(`^merge_maps\((attributes|resource\.attributes),\s*ParseJSON\(` + ottlPath +
+		`\),\s*"(?:upsert|insert|update)"\)$`)
+	ottlExtractPatterns = regexp.MustCompile(`^merge_maps\((attributes|resource\.attributes),\s*ExtractPatterns\(` + ottlPath +
+		`,\s*` + ottlString + `\),\s*"(?:upsert|insert|update)"\)$`)
+	ottlKey = regexp.MustCompile(`\["([^"]+)"\]$`)
+)
+
+// ProcessorsFromCollector - Converts the log processors of an OpenTelemetry
+// collector configuration, keyed by processor ID such as transform/nginx, to
+// pipeline operators. The processors are converted in the order of their IDs,
+// as a map has no order. Only a subset of each processor can be converted,
+// anything else is reported as an error.
+func ProcessorsFromCollector(processors map[string]interface{}) ([]PipelineOperator, error) {
+	ids := make([]string, 0, len(processors))
+	for id := range processors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var operators []PipelineOperator
+	for _, id := range ids {
+		config, ok := processors[id].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: expected an object", id)
+		}
+
+		var (
+			converted []PipelineOperator
+			err       error
+		)
+		switch processorType, _, _ := strings.Cut(id, "/"); processorType {
+		case CollectorProcessorAttributes:
+			converted, err = operatorsFromAttributes(config)
+		case CollectorProcessorLogsTransform:
+			converted, err = operatorsFromLogsTransform(config)
+		case CollectorProcessorTransform:
+			converted, err = operatorsFromTransform(config)
+		default:
+			err = fmt.Errorf("unsupported processor type %q, expected one of: %s",
+				processorType, strings.Join(CollectorProcessors, ", "))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", id, err)
+		}
+
+		operators = append(operators, converted...)
+	}
+
+	for i := range operators {
+		operators[i].OrderID = i + 1
+		operators[i].Enabled = true
+		if operators[i].Name == "" {
+			operators[i].Name = fmt.Sprintf("%s %d", operators[i].Type, i+1)
+		}
+	}
+
+	return operators, nil
+}
+
+// operatorsFromAttributes - Converts the actions of an attributes processor.
+func operatorsFromAttributes(config map[string]interface{}) ([]PipelineOperator, error) {
+	actions, ok := config["actions"].([]interface{})
+	if !ok {
+		return nil, errors.New("expected a list of actions")
+	}
+
+	operators := make([]PipelineOperator, 0, len(actions))
+	for i, rawAction := range actions {
+		action, _ := rawAction.(map[string]interface{})
+		key, _ := action["key"].(string)
+		if key == "" {
+			return nil, fmt.Errorf("actions[%d]: missing key", i)
+		}
+		field := "attributes." + key
+
+		switch action["action"] {
+		case "insert", "update", "upsert":
+			if from, ok := action["from_attribute"].(string); ok {
+				operators = append(operators, PipelineOperator{Type: PipelineProcessorCopy, From: "attributes." + from, To: field})
+				continue
+			}
+			value, ok := action["value"]
+			if !ok || value == nil {
+				return nil, fmt.Errorf("actions[%d]: only value and from_attribute are supported", i)
+			}
+			operators = append(operators, PipelineOperator{Type: PipelineProcessorAdd, Field: field, Value: fmt.Sprint(value)})
+		case "delete":
+			operators = append(operators, PipelineOperator{Type: PipelineProcessorRemove, Field: field})
+		default:
+			return nil, fmt.Errorf("actions[%d]: unsupported action %v, expected one of: insert, update, upsert, delete", i, action["action"])
+		}
+	}
+
+	return operators, nil
+}
+
+// operatorsFromLogsTransform - Converts the stanza operators of a logstransform
+// processor, which SigNoz pipelines are built on.
+func operatorsFromLogsTransform(config map[string]interface{}) ([]PipelineOperator, error) {
+	rawOperators, ok := config["operators"].([]interface{})
+	if !ok {
+		return nil, errors.New("expected a list of operators")
+	}
+
+	operators := make([]PipelineOperator, 0, len(rawOperators))
+	for i, rawOperator := range rawOperators {
+		stanza, _ := rawOperator.(map[string]interface{})
+		str := func(key string) string {
+			value, _ := stanza[key].(string)
+			return value
+		}
+		parseFrom := func(key string) *PipelineParseFrom {
+			value, ok := stanza[key].(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			field, _ := value["parse_from"].(string)
+			return &PipelineParseFrom{ParseFrom: field}
+		}
+
+		operator := PipelineOperator{Type: str("type"), Name: str("id")}
+		switch operator.Type {
+		case PipelineProcessorRegexParser, PipelineProcessorJSONParser:
+			operator.Regex = str("regex")
+			operator.ParseFrom = str("parse_from")
+			operator.ParseTo = str("parse_to")
+			operator.OnError = str("on_error")
+		case PipelineProcessorTraceParser:
+			operator.TraceID = parseFrom("trace_id")
+			operator.SpanID = parseFrom("span_id")
+			operator.TraceFlags = parseFrom("trace_flags")
+		case PipelineProcessorAdd:
+			operator.Field = str("field")
+			operator.Value = fmt.Sprint(stanza["value"])
+		case PipelineProcessorRemove:
+			operator.Field = str("field")
+		case PipelineProcessorMove, PipelineProcessorCopy:
+			operator.From = str("from")
+			operator.To = str("to")
+		case PipelineProcessorSeverityParser:
+			operator.ParseFrom = str("parse_from")
+			operator.OverwriteText = true
+			mapping, err := severityMapping(stanza["mapping"])
+			if err != nil {
+				return nil, fmt.Errorf("operators[%d]: %w", i, err)
+			}
+			operator.Mapping = mapping
+		default:
+			return nil, fmt.Errorf("operators[%d]: unsupported operator type %q", i, operator.Type)
+		}
+
+		operators = append(operators, operator)
+	}
+
+	return operators, nil
+}
+
+// severityMapping - Converts a stanza severity mapping, whose values are a
+// single value or a list of values.
+func severityMapping(raw interface{}) (map[string][]string, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	rawMapping, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("expected an object as severity mapping")
+	}
+
+	mapping := make(map[string][]string, len(rawMapping))
+	for level, values := range rawMapping {
+		switch v := values.(type) {
+		case []interface{}:
+			for _, value := range v {
+				if _, ok := value.(map[string]interface{}); ok {
+					return nil, fmt.Errorf("severity %s: ranges of values are not supported", level)
+				}
+				mapping[level] = append(mapping[level], fmt.Sprint(value))
+			}
+		case map[string]interface{}:
+			return nil, fmt.Errorf("severity %s: ranges of values are not supported", level)
+		default:
+			mapping[level] = []string{fmt.Sprint(v)}
+		}
+	}
+
+	return mapping, nil
+}
+
+// operatorsFromTransform - Converts the log statements of a transform
+// processor, given as a list of statements or of groups of statements.
+func operatorsFromTransform(config map[string]interface{}) ([]PipelineOperator, error) {
+	groups, ok := config["log_statements"].([]interface{})
+	if !ok {
+		return nil, errors.New("expected a list of log_statements")
+	}
+
+	var operators []PipelineOperator
+	for i, group := range groups {
+		groupPath := fmt.Sprintf("log_statements[%d]", i)
+		object, ok := group.(map[string]interface{})
+		if !ok {
+			operator, err := operatorFromStatement(group)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", groupPath, err)
+			}
+			operators = append(operators, operator)
+			continue
+		}
+
+		if logContext, ok := object["context"].(string); ok && logContext != "log" {
+			return nil, fmt.Errorf("%s: unsupported context %q, expected log", groupPath, logContext)
+		}
+		if len(asSlice(object["conditions"])) > 0 {
+			return nil, fmt.Errorf("%s: conditions are not supported, use the pipeline filter", groupPath)
+		}
+		for j, statement := range asSlice(object["statements"]) {
+			operator, err := operatorFromStatement(statement)
+			if err != nil {
+				return nil, fmt.Errorf("%s.statements[%d]: %w", groupPath, j, err)
+			}
+			operators = append(operators, operator)
+		}
+	}
+
+	return operators, nil
+}
+
+// operatorFromStatement - Converts a single OTTL statement.
+func operatorFromStatement(rawStatement interface{}) (PipelineOperator, error) {
+	statement, ok := rawStatement.(string)
+	if !ok {
+		return PipelineOperator{}, errors.New("expected a statement string")
+	}
+	statement = strings.TrimSpace(statement)
+	if strings.Contains(statement, " where ") {
+		return PipelineOperator{}, fmt.Errorf("where clauses are not supported, use the pipeline filter: %s", statement)
+	}
+
+	if m := ottlSetLiteral.FindStringSubmatch(statement); m != nil {
+		return PipelineOperator{Type: PipelineProcessorAdd, Field: fieldFromOTTL(m[1]), Value: unquoteOTTL(m[2])}, nil
+	}
+	if m := ottlSetPath.FindStringSubmatch(statement); m != nil {
+		return PipelineOperator{Type: PipelineProcessorCopy, From: fieldFromOTTL(m[2]), To: fieldFromOTTL(m[1])}, nil
+	}
+	if m := ottlDeleteKey.FindStringSubmatch(statement); m != nil {
+		return PipelineOperator{Type: PipelineProcessorRemove, Field: fieldFromOTTL(m[1] + `["` + m[2] + `"]`)}, nil
+	}
+	if m := ottlParseJSON.FindStringSubmatch(statement); m != nil {
+		return PipelineOperator{
+			Type:      PipelineProcessorJSONParser,
+			ParseFrom: fieldFromOTTL(m[2]),
+			ParseTo:   fieldFromOTTL(m[1]),
+			OnError:   PipelineOnErrorSend,
+		}, nil
+	}
+	if m := ottlExtractPatterns.FindStringSubmatch(statement); m != nil {
+		return PipelineOperator{
+			Type:      PipelineProcessorRegexParser,
+			Regex:     unquoteOTTL(m[3]),
+			ParseFrom: fieldFromOTTL(m[2]),
+			ParseTo:   fieldFromOTTL(m[1]),
+			OnError:   PipelineOnErrorSend,
+		}, nil
+	}
+
+	return PipelineOperator{}, fmt.Errorf("unsupported statement, expected set, delete_key or merge_maps "+
+		"with ParseJSON or ExtractPatterns: %s", statement)
+}
+
+// fieldFromOTTL - Converts an OTTL path, such as resource.attributes["key"],
+// to a pipeline field, such as resource.key.
+func fieldFromOTTL(path string) string {
+	prefix := "attributes"
+	if strings.HasPrefix(path, "resource.") {
+		prefix = "resource"
+	}
+
+	m := ottlKey.FindStringSubmatch(path)
+	switch {
+	case path == "body":
+		return path
+	case m == nil:
+		return prefix
+	default:
+		return prefix + "." + m[1]
+	}
+}
+
+// unquoteOTTL - Returns the value of an OTTL string literal without quotes.
+func unquoteOTTL(value string) string {
+	return strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(value)
+}
+
+// asSlice - Returns the value as a slice, or nil if it is not one.
+func asSlice(value interface{}) []interface{} {
+	slice, _ := value.([]interface{})
+	return slice
+}
@@ -0,0 +1,333 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+)
+
+const (
+	ConditionQueryTypeBuilder = "builder"
+	ConditionQueryTypePromQL  = "promql"
+
+	conditionQueryName = "A"
+
+	ConditionKeyAbsentFor     = "absentFor"
+	ConditionKeyAlertOnAbsent = "alertOnAbsent"
+	ConditionKeyCHQueries     = "compositeQuery.chQueries"
+	ConditionKeyMatchType     = "matchType"
+	ConditionKeyOp            = "op"
+	ConditionKeyPromQueries   = "compositeQuery.promQueries"
+	ConditionKeySelectedQuery = "selectedQueryName"
+	ConditionKeyTarget        = "target"
+	ConditionKeyTargetUnit    = "targetUnit"
+	ConditionKeyThresholds    = "thresholds"
+	ConditionKeyYAxisUnit     = "compositeQuery.unit"
+
+	ConditionVersionV3 = "v3"
+	ConditionVersionV4 = "v4"
+	ConditionVersionV5 = "v5"
+)
+
+//nolint:gochecknoglobals
+var (
+	// ConditionOps maps the comparison operators of threshold conditions to their API values.
+	ConditionOps = map[string]string{
+		"above":     "1",
+		"below":     "2",
+		"equal":     "3",
+		"not_equal": "4",
+	}
+
+	// ConditionLegacyThresholdKeys are the keys of the single target of legacy
+	// conditions, which the thresholds replace.
+	ConditionLegacyThresholdKeys = []string{ConditionKeyMatchType, ConditionKeyOp, ConditionKeyTarget, ConditionKeyTargetUnit}
+
+	// ConditionMatchTypes maps the match types of threshold conditions to their API values.
+	ConditionMatchTypes = map[string]string{
+		"at_least_once": "1",
+		"all_the_times": "2",
+		"on_average":    "3",
+		"in_total":      "4",
+		"last":          "5",
+	}
+)
+
+// ConditionQuery - ClickHouse or PromQL query of a condition.
+type ConditionQuery struct {
+	Name     string `json:"name"`
+	Query    string `json:"query"`
+	Disabled bool   `json:"disabled"`
+	Legend   string `json:"legend"`
+}
+
+// Condition - Condition of an alert rule. Fields the provider does not model
+// are kept in Unknown, so that conditions read from SigNoz or the
+// configuration marshal back as they were.
+type Condition struct {
+	CompositeQuery    *CompositeQuery      `json:"compositeQuery"`
+	Op                *string              `json:"op"`
+	Target            *float64             `json:"target"`
+	TargetUnit        *string              `json:"targetUnit"`
+	MatchType         *string              `json:"matchType"`
+	SelectedQueryName *string              `json:"selectedQueryName"`
+	AlertOnAbsent     *bool                `json:"alertOnAbsent"`
+	AbsentFor         *int64               `json:"absentFor"`
+	Thresholds        *ConditionThresholds `json:"thresholds"`
+	// Unknown holds the fields which are not modeled, by key.
+	Unknown map[string]interface{} `json:"-"`
+}
+
+// CompositeQuery - Queries of a condition. The v5 queries are not modeled.
+type CompositeQuery struct {
+	QueryType      *string                  `json:"queryType"`
+	PanelType      *string                  `json:"panelType"`
+	Unit           *string                  `json:"unit"`
+	BuilderQueries map[string]*BuilderQuery `json:"builderQueries"`
+	PromQueries    map[string]*PromQuery    `json:"promQueries"`
+	ChQueries      map[string]*ChQuery      `json:"chQueries"`
+	Queries        []interface{}            `json:"queries"`
+	Unknown        map[string]interface{}   `json:"-"`
+}
+
+// BuilderQuery - Query builder query of a condition.
+type BuilderQuery struct {
+	QueryName          *string                `json:"queryName"`
+	Expression         *string                `json:"expression"`
+	DataSource         *string                `json:"dataSource"`
+	AggregateOperator  *string                `json:"aggregateOperator"`
+	AggregateAttribute map[string]interface{} `json:"aggregateAttribute"`
+	TimeAggregation    *string                `json:"timeAggregation"`
+	SpaceAggregation   *string                `json:"spaceAggregation"`
+	Filters            map[string]interface{} `json:"filters"`
+	GroupBy            []interface{}          `json:"groupBy"`
+	ReduceTo           *string                `json:"reduceTo"`
+	StepInterval       *int64                 `json:"stepInterval"`
+	Legend             *string                `json:"legend"`
+	Disabled           *bool                  `json:"disabled"`
+	Unknown            map[string]interface{} `json:"-"`
+}
+
+// PromQuery - PromQL query of a condition.
+type PromQuery struct {
+	Name     *string                `json:"name"`
+	Query    *string                `json:"query"`
+	Legend   *string                `json:"legend"`
+	Disabled *bool                  `json:"disabled"`
+	Unknown  map[string]interface{} `json:"-"`
+}
+
+// ChQuery - ClickHouse query of a condition.
+type ChQuery struct {
+	Name     *string                `json:"name"`
+	Query    *string                `json:"query"`
+	Legend   *string                `json:"legend"`
+	Disabled *bool                  `json:"disabled"`
+	Unknown  map[string]interface{} `json:"-"`
+}
+
+// ConditionThresholds - Thresholds of a rule with multiple thresholds.
+type ConditionThresholds struct {
+	Kind    *string                `json:"kind"`
+	Spec    []*Threshold           `json:"spec"`
+	Unknown map[string]interface{} `json:"-"`
+}
+
+// Threshold - Threshold of a rule with multiple thresholds, as sent to SigNoz.
+type Threshold struct {
+	Name           *string                `json:"name"`
+	Target         *float64               `json:"target"`
+	TargetUnit     *string                `json:"targetUnit"`
+	RecoveryTarget *float64               `json:"recoveryTarget"`
+	MatchType      *string                `json:"matchType"`
+	Op             *string                `json:"op"`
+	Channels       []string               `json:"channels"`
+	Unknown        map[string]interface{} `json:"-"`
+}
+
+func (c Condition) MarshalJSON() ([]byte, error) { return marshalObject(&c, c.Unknown) }
+
+func (c *Condition) UnmarshalJSON(data []byte) (err error) {
+	c.Unknown, err = unmarshalObject(data, c)
+	return err
+}
+
+// Equal - Returns true if both conditions marshal to the same JSON.
+func (c *Condition) Equal(other *Condition) bool { return equalObjects(c, other) }
+
+// Map - Returns the condition as a generic JSON object, such as to normalize
+// it. It returns nil if the condition is nil.
+func (c *Condition) Map() map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+
+	return objectMap(c)
+}
+
+func (q CompositeQuery) MarshalJSON() ([]byte, error) { return marshalObject(&q, q.Unknown) }
+
+func (q *CompositeQuery) UnmarshalJSON(data []byte) (err error) {
+	q.Unknown, err = unmarshalObject(data, q)
+	return err
+}
+
+// Equal - Returns true if both composite queries marshal to the same JSON.
+func (q *CompositeQuery) Equal(other *CompositeQuery) bool { return equalObjects(q, other) }
+
+func (q BuilderQuery) MarshalJSON() ([]byte, error) { return marshalObject(&q, q.Unknown) }
+
+func (q *BuilderQuery) UnmarshalJSON(data []byte) (err error) {
+	q.Unknown, err = unmarshalObject(data, q)
+	return err
+}
+
+// Equal - Returns true if both builder queries marshal to the same JSON.
+func (q *BuilderQuery) Equal(other *BuilderQuery) bool { return equalObjects(q, other) }
+
+func (q PromQuery) MarshalJSON() ([]byte, error) { return marshalObject(&q, q.Unknown) }
+
+func (q *PromQuery) UnmarshalJSON(data []byte) (err error) {
+	q.Unknown, err = unmarshalObject(data, q)
+	return err
+}
+
+// Equal - Returns true if both PromQL queries marshal to the same JSON.
+func (q *PromQuery) Equal(other *PromQuery) bool { return equalObjects(q, other) }
+
+func (q ChQuery) MarshalJSON() ([]byte, error) { return marshalObject(&q, q.Unknown) }
+
+func (q *ChQuery) UnmarshalJSON(data []byte) (err error) {
+	q.Unknown, err = unmarshalObject(data, q)
+	return err
+}
+
+// Equal - Returns true if both ClickHouse queries marshal to the same JSON.
+func (q *ChQuery) Equal(other *ChQuery) bool { return equalObjects(q, other) }
+
+func (t ConditionThresholds) MarshalJSON() ([]byte, error) { return marshalObject(&t, t.Unknown) }
+
+func (t *ConditionThresholds) UnmarshalJSON(data []byte) (err error) {
+	t.Unknown, err = unmarshalObject(data, t)
+	return err
+}
+
+// Equal - Returns true if both thresholds marshal to the same JSON.
+func (t *ConditionThresholds) Equal(other *ConditionThresholds) bool { return equalObjects(t, other) }
+
+func (t Threshold) MarshalJSON() ([]byte, error) { return marshalObject(&t, t.Unknown) }
+
+func (t *Threshold) UnmarshalJSON(data []byte) (err error) {
+	t.Unknown, err = unmarshalObject(data, t)
+	return err
+}
+
+// Equal - Returns true if both thresholds marshal to the same JSON.
+func (t *Threshold) Equal(other *Threshold) bool { return equalObjects(t, other) }
+
+// ConditionValueName - Returns the name of the API value in the values, such
+// as above for the op 1. Names and unknown values are returned as is.
+func ConditionValueName(values map[string]string, value string) string {
+	if _, ok := values[value]; ok {
+		return value
+	}
+	for name, apiValue := range values {
+		if apiValue == value {
+			return name
+		}
+	}
+
+	return value
+}
+
+// Version - Detects the alert payload version from the shape of the
+// condition. It returns an empty string if the shape fits several versions,
+// e.g. for PromQL queries.
+//
+// v5 conditions list their queries in compositeQuery.queries, while v3 and v4
+// conditions map them in compositeQuery.builderQueries. v3 metrics queries
+// only have an aggregateOperator, which v4 splits into timeAggregation and
+// spaceAggregation.
+func (c *Condition) Version() string {
+	if c == nil || c.CompositeQuery == nil {
+		return ""
+	}
+	if c.CompositeQuery.Queries != nil {
+		return ConditionVersionV5
+	}
+
+	version := ""
+	for _, query := range c.CompositeQuery.BuilderQueries {
+		if query == nil || query.DataSource == nil || *query.DataSource != conditionDataSourceMetrics {
+			continue
+		}
+		switch {
+		case query.TimeAggregation != nil || query.SpaceAggregation != nil:
+			return ConditionVersionV4
+		case query.AggregateOperator != nil:
+			version = ConditionVersionV3
+		}
+	}
+
+	return version
+}
+
+// ThresholdCondition - Options of a threshold rule condition.
+type ThresholdCondition struct {
+	// BuilderQuery is a query builder query. It is mutually exclusive with PromQL.
+	BuilderQuery *BuilderQuery
+	PromQL       string
+	Op           string
+	Target       float64
+	MatchType    string
+	Unit         string
+	TargetUnit   string
+}
+
+// ToCondition - Returns the v4 condition of a threshold rule.
+func (t ThresholdCondition) ToCondition() (*Condition, error) {
+	op, ok := ConditionOps[t.Op]
+	if !ok {
+		return nil, fmt.Errorf("invalid op %q, expected one of: %s", t.Op, strings.Join(utils.SortedKeys(ConditionOps), ", "))
+	}
+	matchType, ok := ConditionMatchTypes[t.MatchType]
+	if !ok {
+		return nil, fmt.Errorf("invalid match type %q, expected one of: %s", t.MatchType, strings.Join(utils.SortedKeys(ConditionMatchTypes), ", "))
+	}
+	if err := CompatibleUnits(t.TargetUnit, t.Unit); err != nil {
+		return nil, fmt.Errorf("invalid units: %w", err)
+	}
+
+	compositeQuery := &CompositeQuery{
+		PanelType: utils.Ptr("graph"),
+		Unit:      utils.Ptr(t.Unit),
+	}
+	if t.BuilderQuery != nil {
+		query := *t.BuilderQuery
+		query.QueryName = utils.Ptr(conditionQueryName)
+		if query.Expression == nil {
+			query.Expression = utils.Ptr(conditionQueryName)
+		}
+		compositeQuery.QueryType = utils.Ptr(ConditionQueryTypeBuilder)
+		compositeQuery.BuilderQueries = map[string]*BuilderQuery{conditionQueryName: &query}
+	} else {
+		compositeQuery.QueryType = utils.Ptr(ConditionQueryTypePromQL)
+		compositeQuery.PromQueries = map[string]*PromQuery{
+			conditionQueryName: {
+				Name:     utils.Ptr(conditionQueryName),
+				Query:    utils.Ptr(t.PromQL),
+				Disabled: utils.Ptr(false),
+			},
+		}
+	}
+
+	return &Condition{
+		CompositeQuery:    compositeQuery,
+		Op:                utils.Ptr(op),
+		Target:            utils.Ptr(t.Target),
+		MatchType:         utils.Ptr(matchType),
+		TargetUnit:        utils.Ptr(t.TargetUnit),
+		SelectedQueryName: utils.Ptr(conditionQueryName),
+	}, nil
+}
@@ -0,0 +1,385 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+)
+
+const (
+	// conditionDataSourceMetrics - Data source of the metrics builder queries.
+	conditionDataSourceMetrics = "metrics"
+
+	// v5 query envelope types.
+	queryEnvelopeBuilder    = "builder_query"
+	queryEnvelopeFormula    = "builder_formula"
+	queryEnvelopePromQL     = "promql"
+	queryEnvelopeClickHouse = "clickhouse_sql"
+)
+
+//nolint:gochecknoglobals
+var (
+	// v3Aggregations maps the v3 aggregate operators of metrics queries to the
+	// v4 time and space aggregations they split into.
+	v3Aggregations = map[string][2]string{
+		"count":            {"count", "sum"},
+		"count_distinct":   {"count_distinct", "sum"},
+		"sum":              {"sum", "sum"},
+		"avg":              {"avg", "avg"},
+		"min":              {"min", "min"},
+		"max":              {"max", "max"},
+		"rate":             {"rate", "sum"},
+		"sum_rate":         {"rate", "sum"},
+		"avg_rate":         {"rate", "avg"},
+		"min_rate":         {"rate", "min"},
+		"max_rate":         {"rate", "max"},
+		"hist_quantile_50": {"", "p50"},
+		"hist_quantile_75": {"", "p75"},
+		"hist_quantile_90": {"", "p90"},
+		"hist_quantile_95": {"", "p95"},
+		"hist_quantile_99": {"", "p99"},
+	}
+
+	// v5FilterOperators maps the operators of the v3 and v4 filter items to
+	// the operators of the v5 filter expressions.
+	v5FilterOperators = map[string]string{
+		"=":         "=",
+		"!=":        "!=",
+		">":         ">",
+		">=":        ">=",
+		"<":         "<",
+		"<=":        "<=",
+		"in":        "IN",
+		"nin":       "NOT IN",
+		"like":      "LIKE",
+		"nlike":     "NOT LIKE",
+		"ilike":     "ILIKE",
+		"nilike":    "NOT ILIKE",
+		"contains":  "CONTAINS",
+		"ncontains": "NOT CONTAINS",
+		"regex":     "REGEXP",
+		"nregex":    "NOT REGEXP",
+		"exists":    "EXISTS",
+		"nexists":   "NOT EXISTS",
+	}
+
+	// conditionVersions - Condition versions from the oldest to the newest.
+	conditionVersions = []string{ConditionVersionV3, ConditionVersionV4, ConditionVersionV5}
+)
+
+// Migrate - Returns a copy of the condition converted to the version, one
+// version at a time. Conditions whose version cannot be detected, such as
+// PromQL conditions, or which already have the version are returned as is.
+// v4 conditions can be downgraded to v3 when their aggregations have a v3
+// equivalent, while v5 conditions cannot be downgraded.
+func (c *Condition) Migrate(version string) (*Condition, error) {
+	current := c.Version()
+	from := slices.Index(conditionVersions, current)
+	to := slices.Index(conditionVersions, version)
+	if from < 0 || to < 0 || from == to {
+		return c, nil
+	}
+
+	migrated, err := c.copy()
+	if err != nil {
+		return nil, err
+	}
+	for ; from < to; from++ {
+		switch conditionVersions[from] {
+		case ConditionVersionV3:
+			migrated.upgradeToV4()
+		case ConditionVersionV4:
+			if err := migrated.upgradeToV5(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for ; from > to; from-- {
+		switch conditionVersions[from] {
+		case ConditionVersionV5:
+			return nil, fmt.Errorf("unable to convert the %s condition to %s: v5 conditions cannot be downgraded", current, version)
+		case ConditionVersionV4:
+			if err := migrated.downgradeToV3(); err != nil {
+				return nil, fmt.Errorf("unable to convert the %s condition to %s: %w", current, version, err)
+			}
+		}
+	}
+
+	return migrated, nil
+}
+
+// copy - Returns a deep copy of the condition.
+func (c *Condition) copy() (*Condition, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var copied Condition
+	if err := json.Unmarshal(b, &copied); err != nil {
+		return nil, err
+	}
+
+	return &copied, nil
+}
+
+// metricsQueries - Returns the metrics builder queries of the condition.
+func (c *Condition) metricsQueries() []*BuilderQuery {
+	var queries []*BuilderQuery
+	for _, name := range utils.SortedKeys(c.CompositeQuery.BuilderQueries) {
+		query := c.CompositeQuery.BuilderQueries[name]
+		if query != nil && query.DataSource != nil && *query.DataSource == conditionDataSourceMetrics {
+			queries = append(queries, query)
+		}
+	}
+
+	return queries
+}
+
+// upgradeToV4 - Splits the aggregate operator of the v3 metrics queries into
+// a time and a space aggregation.
+func (c *Condition) upgradeToV4() {
+	for _, query := range c.metricsQueries() {
+		if query.AggregateOperator == nil || query.TimeAggregation != nil || query.SpaceAggregation != nil {
+			continue
+		}
+		if aggregations, ok := v3Aggregations[*query.AggregateOperator]; ok {
+			query.TimeAggregation = utils.Ptr(aggregations[0])
+			query.SpaceAggregation = utils.Ptr(aggregations[1])
+		}
+	}
+}
+
+// downgradeToV3 - Replaces the time and space aggregations of the v4 metrics
+// queries with the equivalent aggregate operator.
+func (c *Condition) downgradeToV3() error {
+	for _, query := range c.metricsQueries() {
+		if query.TimeAggregation == nil && query.SpaceAggregation == nil {
+			continue
+		}
+		aggregations := [2]string{stringValue(query.TimeAggregation), stringValue(query.SpaceAggregation)}
+		if query.AggregateOperator == nil {
+			for _, operator := range utils.SortedKeys(v3Aggregations) {
+				if v3Aggregations[operator] == aggregations {
+					query.AggregateOperator = utils.Ptr(operator)
+					break
+				}
+			}
+		}
+		if query.AggregateOperator == nil {
+			return fmt.Errorf("query %s: time aggregation %q with space aggregation %q has no v3 equivalent",
+				stringValue(query.QueryName), aggregations[0], aggregations[1])
+		}
+		query.TimeAggregation = nil
+		query.SpaceAggregation = nil
+	}
+
+	return nil
+}
+
+// upgradeToV5 - Moves the builder, PromQL and ClickHouse queries to the v5
+// query envelopes, sorted by name.
+func (c *Condition) upgradeToV5() error {
+	compositeQuery := c.CompositeQuery
+	queries := make([]interface{}, 0)
+
+	for _, name := range utils.SortedKeys(compositeQuery.BuilderQueries) {
+		envelope, err := builderQueryEnvelope(name, compositeQuery.BuilderQueries[name])
+		if err != nil {
+			return fmt.Errorf("unable to convert query %s to v5: %w", name, err)
+		}
+		queries = append(queries, envelope)
+	}
+	for _, name := range utils.SortedKeys(compositeQuery.PromQueries) {
+		queries = append(queries, rawQueryEnvelope(queryEnvelopePromQL, name, compositeQuery.PromQueries[name]))
+	}
+	for _, name := range utils.SortedKeys(compositeQuery.ChQueries) {
+		queries = append(queries, rawQueryEnvelope(queryEnvelopeClickHouse, name, compositeQuery.ChQueries[name]))
+	}
+
+	compositeQuery.BuilderQueries = nil
+	compositeQuery.PromQueries = nil
+	compositeQuery.ChQueries = nil
+	compositeQuery.Queries = queries
+
+	return nil
+}
+
+// rawQueryEnvelope - Returns the v5 envelope of the PromQL or ClickHouse query,
+// whose fields are unchanged in v5.
+func rawQueryEnvelope(envelopeType, name string, query any) map[string]interface{} {
+	spec := objectMap(query)
+	if spec == nil {
+		spec = map[string]interface{}{}
+	}
+	spec["name"] = name
+
+	return map[string]interface{}{"type": envelopeType, "spec": spec}
+}
+
+// builderQueryEnvelope - Returns the v5 envelope of the builder query or
+// formula.
+func builderQueryEnvelope(name string, query *BuilderQuery) (map[string]interface{}, error) {
+	if query == nil {
+		return nil, fmt.Errorf("query is null")
+	}
+
+	spec := map[string]interface{}{"name": name}
+	if query.Disabled != nil {
+		spec["disabled"] = *query.Disabled
+	}
+	if query.Legend != nil {
+		spec["legend"] = *query.Legend
+	}
+
+	// Formulas have no data source.
+	if query.DataSource == nil {
+		spec["expression"] = stringValue(query.Expression)
+		return map[string]interface{}{"type": queryEnvelopeFormula, "spec": spec}, nil
+	}
+
+	spec["signal"] = *query.DataSource
+	if query.StepInterval != nil {
+		spec["stepInterval"] = *query.StepInterval
+	}
+
+	aggregation, err := v5Aggregation(query)
+	if err != nil {
+		return nil, err
+	}
+	if aggregation != nil {
+		spec["aggregations"] = []interface{}{aggregation}
+	}
+
+	filter, err := v5FilterExpression(query.Filters)
+	if err != nil {
+		return nil, err
+	}
+	if filter != "" {
+		spec["filter"] = map[string]interface{}{"expression": filter}
+	}
+
+	if len(query.GroupBy) > 0 {
+		groupBy := make([]interface{}, 0, len(query.GroupBy))
+		for _, key := range query.GroupBy {
+			name := attributeKey(key)
+			if name == "" {
+				return nil, fmt.Errorf("group by key without name")
+			}
+			groupBy = append(groupBy, map[string]interface{}{"name": name})
+		}
+		spec["groupBy"] = groupBy
+	}
+
+	return map[string]interface{}{"type": queryEnvelopeBuilder, "spec": spec}, nil
+}
+
+// v5Aggregation - Returns the v5 aggregation of the builder query, or nil if
+// it has none.
+func v5Aggregation(query *BuilderQuery) (map[string]interface{}, error) {
+	attribute := attributeKey(query.AggregateAttribute)
+
+	if *query.DataSource == conditionDataSourceMetrics {
+		timeAggregation, spaceAggregation := stringValue(query.TimeAggregation), stringValue(query.SpaceAggregation)
+		if query.TimeAggregation == nil && query.SpaceAggregation == nil && query.AggregateOperator != nil {
+			aggregations := v3Aggregations[*query.AggregateOperator]
+			timeAggregation, spaceAggregation = aggregations[0], aggregations[1]
+		}
+		aggregation := map[string]interface{}{
+			"metricName":       attribute,
+			"timeAggregation":  timeAggregation,
+			"spaceAggregation": spaceAggregation,
+		}
+		if query.ReduceTo != nil {
+			aggregation["reduceTo"] = *query.ReduceTo
+		}
+		return aggregation, nil
+	}
+
+	operator := stringValue(query.AggregateOperator)
+	switch {
+	case operator == "" || operator == "noop":
+		return nil, nil
+	case operator == "count" && attribute == "":
+		return map[string]interface{}{"expression": "count()"}, nil
+	case attribute == "":
+		return nil, fmt.Errorf("aggregate operator %q without attribute", operator)
+	default:
+		return map[string]interface{}{"expression": fmt.Sprintf("%s(%s)", operator, attribute)}, nil
+	}
+}
+
+// v5FilterExpression - Returns the v5 filter expression of the filter items,
+// joined with their operator.
+func v5FilterExpression(filters map[string]interface{}) (string, error) {
+	items, _ := filters["items"].([]interface{})
+	conditions := make([]string, 0, len(items))
+	for _, value := range items {
+		item, _ := value.(map[string]interface{})
+		key := attributeKey(item["key"])
+		op, _ := item["op"].(string)
+		operator, ok := v5FilterOperators[strings.ToLower(op)]
+		if key == "" || !ok {
+			return "", fmt.Errorf("unsupported filter %v", value)
+		}
+
+		switch operator {
+		case "EXISTS", "NOT EXISTS":
+			conditions = append(conditions, fmt.Sprintf("%s %s", key, operator))
+		case "IN", "NOT IN":
+			values, ok := item["value"].([]interface{})
+			if !ok {
+				values = []interface{}{item["value"]}
+			}
+			literals := utils.Map(values, filterLiteral)
+			conditions = append(conditions, fmt.Sprintf("%s %s (%s)", key, operator, strings.Join(literals, ", ")))
+		default:
+			conditions = append(conditions, fmt.Sprintf("%s %s %s", key, operator, filterLiteral(item["value"])))
+		}
+	}
+
+	op, _ := filters["op"].(string)
+	if op == "" {
+		op = "AND"
+	}
+
+	return strings.Join(conditions, " "+strings.ToUpper(op)+" "), nil
+}
+
+// filterLiteral - Returns the value as a literal of a v5 filter expression.
+func filterLiteral(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return "'" + strings.ReplaceAll(s, "'", `\'`) + "'"
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(b)
+}
+
+// attributeKey - Returns the name of the attribute key, given either as an
+// object with a key field or as a string.
+func attributeKey(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		key, _ := v["key"].(string)
+		return key
+	default:
+		return ""
+	}
+}
+
+// stringValue - Returns the string pointed to, or an empty string if nil.
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
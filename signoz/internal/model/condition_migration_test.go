@@ -0,0 +1,149 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+)
+
+//nolint:gochecknoglobals
+var update = flag.Bool("update", false, "update the golden files of the tests")
+
+// readCondition - Returns the condition of the test data file.
+func readCondition(t *testing.T, name string) *Condition {
+	t.Helper()
+
+	b, err := os.ReadFile(filepath.Join("testdata", "condition_migration", name+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var condition Condition
+	if err := json.Unmarshal(b, &condition); err != nil {
+		t.Fatalf("invalid condition %s: %v", name, err)
+	}
+
+	return &condition
+}
+
+// indentJSON - Returns the value as indented JSON with sorted keys.
+func indentJSON(t *testing.T, v any) []byte {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded interface{}
+	if err := decodeJSON(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	b, err = json.MarshalIndent(decoded, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return append(b, '\n')
+}
+
+func TestConditionMigrate(t *testing.T) {
+	cases := []struct {
+		input   string
+		version string
+		// wantErr is a substring of the error, if the migration fails.
+		wantErr string
+	}{
+		{input: "v3", version: ConditionVersionV4},
+		{input: "v3", version: ConditionVersionV5},
+		{input: "v4", version: ConditionVersionV3},
+		{input: "v4", version: ConditionVersionV5},
+		{input: "hist_quantile", version: ConditionVersionV3},
+		{input: "hist_quantile", version: ConditionVersionV5},
+		{input: "v5", version: ConditionVersionV4, wantErr: "unable to convert the v5 condition to v4: v5 conditions cannot be downgraded"},
+		{input: "v5", version: ConditionVersionV3, wantErr: "unable to convert the v5 condition to v3: v5 conditions cannot be downgraded"},
+		{
+			input:   "v4_no_v3",
+			version: ConditionVersionV3,
+			wantErr: `query A: time aggregation "increase" with space aggregation "sum" has no v3 equivalent`,
+		},
+		{input: "v3_unsupported_filter", version: ConditionVersionV5, wantErr: "unable to convert query A to v5: unsupported filter"},
+	}
+
+	for _, tc := range cases {
+		name := tc.input + "_to_" + tc.version
+		t.Run(name, func(t *testing.T) {
+			condition := readCondition(t, tc.input)
+			before := indentJSON(t, condition)
+
+			migrated, err := condition.Migrate(tc.version)
+			if !bytes.Equal(indentJSON(t, condition), before) {
+				t.Fatalf("Migrate() changed the condition")
+			}
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("Migrate() = %v, want an error with %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Migrate() = %v", err)
+			}
+			if got := migrated.Version(); got != tc.version {
+				t.Fatalf("Version() = %q after the migration, want %q", got, tc.version)
+			}
+
+			got := indentJSON(t, migrated)
+			golden := filepath.Join("testdata", "condition_migration", name+".golden.json")
+			if *update {
+				if err := os.WriteFile(golden, got, 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Fatalf("Migrate() differs from %s\n got: %s\nwant: %s", golden, got, want)
+			}
+		})
+	}
+}
+
+func TestConditionMigrateUnchanged(t *testing.T) {
+	cases := []struct {
+		name      string
+		condition string
+		version   string
+	}{
+		{name: "same version", condition: "v4", version: ConditionVersionV4},
+		{name: "unknown version", condition: "v3", version: "v6"},
+		{name: "no version", condition: "promql", version: ConditionVersionV5},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var condition *Condition
+			if tc.condition == "promql" {
+				condition = &Condition{CompositeQuery: &CompositeQuery{
+					PromQueries: map[string]*PromQuery{"A": {Query: utils.Ptr("up == 0")}},
+				}}
+			} else {
+				condition = readCondition(t, tc.condition)
+			}
+
+			migrated, err := condition.Migrate(tc.version)
+			if err != nil {
+				t.Fatalf("Migrate() = %v", err)
+			}
+			if migrated != condition {
+				t.Fatalf("Migrate() returned a copy, want the condition as is")
+			}
+		})
+	}
+}
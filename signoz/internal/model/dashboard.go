@@ -3,6 +3,7 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
@@ -12,32 +13,46 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
 )
 
+const (
+	DashboardDriftDetectionStrict   = "strict"
+	DashboardDriftDetectionSemantic = "semantic"
+	DashboardDriftDetectionIgnore   = "ignore"
+)
+
+//nolint:gochecknoglobals
+var DashboardDriftDetections = []string{
+	DashboardDriftDetectionStrict, DashboardDriftDetectionSemantic, DashboardDriftDetectionIgnore,
+}
+
 // Dashboard model.
 type Dashboard struct {
-	CollapsableRowsMigrated bool                     `json:"collapsableRowsMigrated"`
-	Description             string                   `json:"description"`
-	Layout                  []map[string]interface{} `json:"layout"`
-	Name                    string                   `json:"name"`
-	PanelMap                map[string]interface{}   `json:"panelMap,omitempty"`
-	Source                  string                   `json:"source"`
-	Tags                    []string                 `json:"tags"`
-	Title                   string                   `json:"title"`
-	UploadedGrafana         bool                     `json:"uploadedGrafana"`
-	Variables               map[string]interface{}   `json:"variables"`
-	Version                 string                   `json:"version,omitempty"`
-	Widgets                 interface{}              `json:"widgets"`
+	CollapsableRowsMigrated bool                       `json:"collapsableRowsMigrated"`
+	Description             string                     `json:"description"`
+	Layout                  []*LayoutItem              `json:"layout"`
+	Name                    string                     `json:"name"`
+	PanelMap                map[string]*DashboardPanel `json:"panelMap,omitempty"`
+	Source                  string                     `json:"source"`
+	Tags                    []string                   `json:"tags"`
+	Title                   string                     `json:"title"`
+	UploadedGrafana         bool                       `json:"uploadedGrafana"`
+	Variables               map[string]interface{}     `json:"variables"`
+	Version                 string                     `json:"version,omitempty"`
+	Widgets                 []*Widget                  `json:"widgets"`
 }
 
 func (d Dashboard) PanelMapToTerraform() (types.String, error) {
 	if d.PanelMap == nil {
 		return types.StringNull(), nil
 	}
-	panelMap, err := structure.FlattenJsonToString(d.PanelMap)
+	if len(d.PanelMap) == 0 {
+		return types.StringValue(""), nil
+	}
+	panelMap, err := json.Marshal(d.PanelMap)
 	if err != nil {
 		return types.StringNull(), err
 	}
 
-	return types.StringValue(panelMap), nil
+	return types.StringValue(string(panelMap)), nil
 }
 
 func (d Dashboard) VariablesToTerraform() (types.String, error) {
@@ -49,10 +64,15 @@ func (d Dashboard) VariablesToTerraform() (types.String, error) {
 	return types.StringValue(variables), nil
 }
 
-func (d Dashboard) TagsToTerraform() (types.List, diag.Diagnostics) {
-	tags := utils.Map(d.Tags, func(value string) tfattr.Value {
-		return types.StringValue(value)
-	})
+// TagsToTerraform - Returns the tags of the dashboard, leaving out the hidden
+// ones, such as the default tags of the provider.
+func (d Dashboard) TagsToTerraform(hiddenTags ...string) (types.List, diag.Diagnostics) {
+	tags := make([]tfattr.Value, 0, len(d.Tags))
+	for _, tag := range d.Tags {
+		if !utils.Contains(hiddenTags, tag) {
+			tags = append(tags, types.StringValue(tag))
+		}
+	}
 
 	return types.ListValue(types.StringType, tags)
 }
@@ -70,20 +90,9 @@ func (d Dashboard) WidgetsToTerraform() (types.String, error) {
 		return types.StringValue("[]"), nil
 	}
 
-	// First marshal to get the data
-	b, err := json.Marshal(d.Widgets)
-	if err != nil {
-		return types.StringValue(""), err
-	}
-
-	// Parse it back to normalize the structure
-	var normalized interface{}
-	if err := json.Unmarshal(b, &normalized); err != nil {
-		return types.StringValue(""), err
-	}
-
-	// Marshal with exact formatting to match API
-	formatted, err := json.MarshalIndent(normalized, "", "  ")
+	// Widgets keep the fields which are not modeled, so they are marshaled
+	// directly with the formatting of the API.
+	formatted, err := json.MarshalIndent(d.Widgets, "", "  ")
 	if err != nil {
 		return types.StringValue(""), err
 	}
@@ -98,12 +107,8 @@ func (d *Dashboard) SetVariables(tfVariables types.String) error {
 		return nil
 	}
 
-	// Debug log the variables string we're trying to parse
-	fmt.Printf("DEBUG: SetVariables attempting to parse: %q\n", variablesStr)
-
 	variables, err := structure.ExpandJsonFromString(variablesStr)
 	if err != nil {
-		fmt.Printf("DEBUG: SetVariables parse error: %v\n", err)
 		return fmt.Errorf("failed to parse variables JSON: %w", err)
 	}
 	d.Variables = variables
@@ -112,26 +117,32 @@ func (d *Dashboard) SetVariables(tfVariables types.String) error {
 
 func (d *Dashboard) SetPanelMap(tfPanelMap types.String) error {
 	if tfPanelMap.ValueString() == "" {
-		d.PanelMap = make(map[string]interface{})
+		d.PanelMap = make(map[string]*DashboardPanel)
 		return nil
 	}
-	panelMap, err := structure.ExpandJsonFromString(tfPanelMap.ValueString())
-	if err != nil {
+	var panelMap map[string]*DashboardPanel
+	if err := json.Unmarshal([]byte(tfPanelMap.ValueString()), &panelMap); err != nil {
 		return err
 	}
 	d.PanelMap = panelMap
 	return nil
 }
 
-func (d *Dashboard) SetTags(tfTags types.List) {
+// SetTags - Sets the tags, followed by the default tags missing from them.
+func (d *Dashboard) SetTags(tfTags types.List, defaultTags []string) {
 	tags := utils.Map(tfTags.Elements(), func(value tfattr.Value) string {
 		return strings.Trim(value.String(), "\"")
 	})
+	for _, tag := range defaultTags {
+		if !utils.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
 	d.Tags = tags
 }
 
 func (d *Dashboard) SetLayout(tfLayout types.String) error {
-	var layout []map[string]interface{}
+	var layout []*LayoutItem
 	err := json.Unmarshal([]byte(tfLayout.ValueString()), &layout)
 	if err != nil {
 		return err
@@ -143,12 +154,11 @@ func (d *Dashboard) SetLayout(tfLayout types.String) error {
 func (d *Dashboard) SetWidgets(tfWidgets types.String) error {
 	widgetsStr := tfWidgets.ValueString()
 	if widgetsStr == "" {
-		d.Widgets = []map[string]interface{}{}
+		d.Widgets = []*Widget{}
 		return nil
 	}
 
-	// Try to parse as JSON first
-	var widgets interface{}
+	var widgets []*Widget
 	if err := json.Unmarshal([]byte(widgetsStr), &widgets); err != nil {
 		return fmt.Errorf("failed to parse widgets JSON: %w", err)
 	}
@@ -160,3 +170,99 @@ func (d *Dashboard) SetWidgets(tfWidgets types.String) error {
 func (d *Dashboard) SetSourceIfEmpty(hostURL string) {
 	d.Source = utils.WithDefault(d.Source, hostURL+"/dashboard")
 }
+
+// DashboardRow - Collapsible row of a dashboard, along with the IDs of the
+// widgets it groups.
+type DashboardRow struct {
+	ID        string
+	Collapsed bool
+	Widgets   []string
+}
+
+// SetRows - Sets the panel map from the rows, copying the layout of their
+// widgets into it in layout order. The widgets of collapsed rows are removed
+// from the layout, as done by the SigNoz UI. The layout must be set first.
+func (d *Dashboard) SetRows(rows []DashboardRow) {
+	collapsed := map[string]bool{}
+	d.PanelMap = make(map[string]*DashboardPanel, len(rows))
+	for _, row := range rows {
+		widgets := make([]*LayoutItem, 0, len(row.Widgets))
+		for _, layout := range d.Layout {
+			if id := layout.GetID(); id != "" && slices.Contains(row.Widgets, id) {
+				widgets = append(widgets, layout)
+				collapsed[id] = collapsed[id] || row.Collapsed
+			}
+		}
+		d.PanelMap[row.ID] = &DashboardPanel{
+			Collapsed: utils.Ptr(row.Collapsed),
+			Widgets:   widgets,
+		}
+	}
+
+	layout := make([]*LayoutItem, 0, len(d.Layout))
+	for _, item := range d.Layout {
+		if id := item.GetID(); id == "" || !collapsed[id] {
+			layout = append(layout, item)
+		}
+	}
+	d.Layout = layout
+}
+
+// Rows - Returns the rows of the panel map, sorted by ID.
+func (d Dashboard) Rows() []DashboardRow {
+	rows := make([]DashboardRow, 0, len(d.PanelMap))
+	for _, id := range utils.SortedKeys(d.PanelMap) {
+		panel := d.PanelMap[id]
+		row := DashboardRow{ID: id, Widgets: []string{}, Collapsed: panel.IsCollapsed()}
+		if panel != nil {
+			for _, layout := range panel.Widgets {
+				if widgetID := layout.GetID(); widgetID != "" {
+					row.Widgets = append(row.Widgets, widgetID)
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// ExpandCollapsedRows - Restores the layout of the widgets of collapsed rows,
+// which SigNoz only keeps in the panel map, right after the layout of their row.
+func (d *Dashboard) ExpandCollapsedRows() {
+	present := make(map[string]bool, len(d.Layout))
+	for _, layout := range d.Layout {
+		if id := layout.GetID(); id != "" {
+			present[id] = true
+		}
+	}
+
+	// hidden returns the layout of the widgets of the row missing from the
+	// layout, if the row is collapsed.
+	hidden := func(rowID string) []*LayoutItem {
+		panel := d.PanelMap[rowID]
+		if !panel.IsCollapsed() {
+			return nil
+		}
+		var layouts []*LayoutItem
+		for _, layout := range panel.Widgets {
+			if id := layout.GetID(); id != "" && !present[id] {
+				layouts = append(layouts, layout)
+				present[id] = true
+			}
+		}
+		return layouts
+	}
+
+	layout := make([]*LayoutItem, 0, len(d.Layout))
+	for _, item := range d.Layout {
+		layout = append(layout, item)
+		if id := item.GetID(); id != "" {
+			layout = append(layout, hidden(id)...)
+		}
+	}
+	for _, id := range utils.SortedKeys(d.PanelMap) {
+		layout = append(layout, hidden(id)...)
+	}
+	d.Layout = layout
+}
@@ -0,0 +1,34 @@
+package model
+
+const (
+	SSOTypeSAML       = "SAML"
+	SSOTypeGoogleAuth = "GOOGLE_AUTH"
+)
+
+//nolint:gochecknoglobals
+var SSOTypes = []string{SSOTypeSAML, SSOTypeGoogleAuth}
+
+// Domain model.
+type Domain struct {
+	ID               string            `json:"id,omitempty"`
+	Name             string            `json:"name"`
+	OrgID            string            `json:"orgId,omitempty"`
+	SSOEnabled       bool              `json:"ssoEnabled"`
+	SSOType          string            `json:"ssoType,omitempty"`
+	SAMLConfig       *SAMLConfig       `json:"samlConfig,omitempty"`
+	GoogleAuthConfig *GoogleAuthConfig `json:"googleAuthConfig,omitempty"`
+}
+
+// SAMLConfig model.
+type SAMLConfig struct {
+	SAMLEntity string `json:"samlEntity"`
+	SAMLIdp    string `json:"samlIdp"`
+	SAMLCert   string `json:"samlCert"`
+}
+
+// GoogleAuthConfig model.
+type GoogleAuthConfig struct {
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+	RedirectURI  string `json:"redirectURI,omitempty"`
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+)
+
+const (
+	DowntimeRepeatDaily   = "daily"
+	DowntimeRepeatWeekly  = "weekly"
+	DowntimeRepeatMonthly = "monthly"
+)
+
+//nolint:gochecknoglobals
+var (
+	DowntimeRepeatTypes = []string{DowntimeRepeatDaily, DowntimeRepeatWeekly, DowntimeRepeatMonthly}
+	DowntimeWeekdays    = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+)
+
+// PlannedDowntime model. A planned downtime silences the alerts during its
+// schedule.
+type PlannedDowntime struct {
+	ID          ObjectID          `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Schedule    *DowntimeSchedule `json:"schedule"`
+	AlertIDs    []string          `json:"alertIds"`
+}
+
+// DowntimeSchedule model. One-off downtimes have a start and end time, while
+// recurring downtimes have a recurrence.
+type DowntimeSchedule struct {
+	Timezone   string              `json:"timezone"`
+	StartTime  string              `json:"startTime,omitempty"`
+	EndTime    string              `json:"endTime,omitempty"`
+	Recurrence *DowntimeRecurrence `json:"recurrence,omitempty"`
+}
+
+// DowntimeRecurrence model.
+type DowntimeRecurrence struct {
+	StartTime  string   `json:"startTime"`
+	Duration   string   `json:"duration"`
+	RepeatType string   `json:"repeatType"`
+	RepeatOn   []string `json:"repeatOn"`
+}
+
+// Validate - Returns an error if the schedule would be rejected by SigNoz.
+func (s DowntimeSchedule) Validate() error {
+	if _, err := time.LoadLocation(s.Timezone); err != nil || s.Timezone == "" {
+		return fmt.Errorf("invalid timezone %q", s.Timezone)
+	}
+	if s.Recurrence == nil {
+		return errors.New("missing recurrence")
+	}
+
+	r := s.Recurrence
+	if _, err := time.Parse(time.RFC3339, r.StartTime); err != nil {
+		return fmt.Errorf("invalid start time %q, expected RFC 3339, e.g. 2024-01-01T02:00:00Z", r.StartTime)
+	}
+	duration, err := time.ParseDuration(r.Duration)
+	if err != nil || duration <= 0 {
+		return fmt.Errorf("invalid duration %q", r.Duration)
+	}
+	if !utils.Contains(DowntimeRepeatTypes, r.RepeatType) {
+		return fmt.Errorf("invalid repeat type %q, expected one of: %s", r.RepeatType, strings.Join(DowntimeRepeatTypes, ", "))
+	}
+
+	if r.RepeatType != DowntimeRepeatWeekly {
+		if len(r.RepeatOn) > 0 {
+			return fmt.Errorf("repeat on weekdays is only supported with the %s repeat type", DowntimeRepeatWeekly)
+		}
+		return nil
+	}
+	if len(r.RepeatOn) == 0 {
+		return fmt.Errorf("at least one weekday is required with the %s repeat type", DowntimeRepeatWeekly)
+	}
+	seen := map[string]bool{}
+	for _, day := range r.RepeatOn {
+		if !utils.Contains(DowntimeWeekdays, day) {
+			return fmt.Errorf("invalid weekday %q, expected one of: %s", day, strings.Join(DowntimeWeekdays, ", "))
+		}
+		if seen[day] {
+			return fmt.Errorf("duplicate weekday %q", day)
+		}
+		seen[day] = true
+	}
+
+	return nil
+}
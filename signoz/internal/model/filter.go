@@ -0,0 +1,162 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+)
+
+//nolint:gochecknoglobals
+var (
+	// FilterSetOperators are the operators combining the items of a filter set.
+	FilterSetOperators = []string{"AND", "OR"}
+	// FilterOperators are the operators of the filter items.
+	FilterOperators = []string{
+		"=", "!=", ">", ">=", "<", "<=", "in", "nin", "like", "nlike", "ilike", "nilike",
+		"contains", "ncontains", "regex", "nregex", "exists", "nexists", "has", "nhas",
+	}
+	// FilterKeyTypes are the types of the attributes filtered on.
+	FilterKeyTypes = []string{"tag", "resource", ""}
+	// FilterKeyDataTypes are the data types of the attributes filtered on.
+	FilterKeyDataTypes = []string{"string", "int64", "float64", "bool", ""}
+
+	// filterOperatorAliases are operators commonly written in place of the
+	// SigNoz ones.
+	filterOperatorAliases = map[string]string{
+		"==": "=", "<>": "!=", "not in": "nin", "not_in": "nin", "not like": "nlike", "not_like": "nlike",
+		"not contains": "ncontains", "not_contains": "ncontains", "not exists": "nexists", "not_exists": "nexists",
+		"=~": "regex", "!~": "nregex",
+	}
+)
+
+// FilterError - Error of a filter set field, identified by its path, such as
+// items[1].op.
+type FilterError struct {
+	Path    string
+	Message string
+}
+
+func (e FilterError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+
+	return e.Path + ": " + e.Message
+}
+
+// ValidateFilterSet - Returns the errors of a query builder filter set, as
+// decoded from JSON, which SigNoz would reject or silently match nothing with.
+func ValidateFilterSet(filter map[string]interface{}) []FilterError {
+	var errs []FilterError
+	add := func(path, format string, args ...any) {
+		errs = append(errs, FilterError{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	op, ok := filter["op"].(string)
+	if !ok || !utils.Contains(FilterSetOperators, strings.ToUpper(op)) {
+		add("op", "invalid operator %v, expected one of: %s", filter["op"], strings.Join(FilterSetOperators, ", "))
+	}
+
+	items, ok := filter["items"].([]interface{})
+	if !ok {
+		add("items", "expected a list of filter items")
+		return errs
+	}
+
+	for i, rawItem := range items {
+		itemPath := fmt.Sprintf("items[%d]", i)
+		item, ok := rawItem.(map[string]interface{})
+		if !ok {
+			add(itemPath, "expected an object with key, op and value")
+			continue
+		}
+
+		key, ok := item["key"].(map[string]interface{})
+		if !ok {
+			add(itemPath+".key", "expected an object with key, type and dataType")
+		} else {
+			if name, _ := key["key"].(string); strings.TrimSpace(name) == "" {
+				add(itemPath+".key.key", "missing attribute name")
+			} else if name != strings.TrimSpace(name) {
+				add(itemPath+".key.key", "attribute name %q has leading or trailing spaces", name)
+			}
+			if keyType, ok := key["type"].(string); key["type"] != nil && (!ok || !utils.Contains(FilterKeyTypes, keyType)) {
+				add(itemPath+".key.type", "invalid type %v, expected one of: tag, resource", key["type"])
+			}
+			if dataType, ok := key["dataType"].(string); key["dataType"] != nil && (!ok || !utils.Contains(FilterKeyDataTypes, dataType)) {
+				add(itemPath+".key.dataType", "invalid data type %v, expected one of: string, int64, float64, bool", key["dataType"])
+			}
+		}
+
+		rawOp, ok := item["op"].(string)
+		if !ok {
+			add(itemPath+".op", "missing operator")
+			continue
+		}
+		// SigNoz lower cases the operators.
+		itemOp := strings.ToLower(strings.TrimSpace(rawOp))
+		if !utils.Contains(FilterOperators, itemOp) {
+			message := fmt.Sprintf("unknown operator %q", rawOp)
+			if suggestion := suggestFilterOperator(rawOp); suggestion != "" {
+				message += fmt.Sprintf(", did you mean %q?", suggestion)
+			}
+			add(itemPath+".op", "%s", message)
+			continue
+		}
+
+		value, hasValue := item["value"]
+		switch itemOp {
+		case "exists", "nexists", "in", "nin":
+		default:
+			if !hasValue || value == nil {
+				add(itemPath+".value", "operator %s expects a value", itemOp)
+			} else if _, ok := value.([]interface{}); ok {
+				add(itemPath+".value", "operator %s expects a single value, use in for a list of values", itemOp)
+			}
+		}
+	}
+
+	return errs
+}
+
+// suggestFilterOperator - Returns the operator that was likely meant, or an
+// empty string if none is close enough.
+func suggestFilterOperator(op string) string {
+	lower := strings.ToLower(strings.TrimSpace(op))
+	if alias, ok := filterOperatorAliases[lower]; ok {
+		return alias
+	}
+
+	best, bestDistance := "", 3
+	for _, candidate := range FilterOperators {
+		if distance := levenshtein(lower, candidate); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	return best
+}
+
+// levenshtein - Returns the edit distance between both strings.
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,187 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+// validateFilterJSON - Returns the errors of the filter set given as JSON.
+func validateFilterJSON(t testing.TB, raw string) []string {
+	t.Helper()
+
+	var filter map[string]interface{}
+	if err := decodeJSON([]byte(raw), &filter); err != nil {
+		t.Fatalf("invalid JSON %s: %v", raw, err)
+	}
+
+	var errs []string
+	for _, err := range ValidateFilterSet(filter) {
+		errs = append(errs, err.Error())
+	}
+
+	return errs
+}
+
+func TestValidateFilterSet(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  string
+		want []string
+	}{
+		{
+			name: "valid",
+			raw: `{"op":"AND","items":[{"key":{"key":"service.name","type":"resource","dataType":"string"},"op":"=","value":"api"},` +
+				`{"key":{"key":"http.status_code","dataType":"int64"},"op":">=","value":500}]}`,
+		},
+		{name: "empty items", raw: `{"op":"or","items":[]}`},
+		{name: "lower case operators", raw: `{"op":"and","items":[{"key":{"key":"body"},"op":" CONTAINS ","value":"error"}]}`},
+
+		// Quoting.
+		{name: "quoted value", raw: `{"op":"AND","items":[{"key":{"key":"body"},"op":"=","value":"it's \"down\""}]}`},
+		{name: "escaped attribute name", raw: `{"op":"AND","items":[{"key":{"key":"labels.\"team\"é"},"op":"exists"}]}`},
+		{
+			name: "attribute name with spaces",
+			raw:  `{"op":"AND","items":[{"key":{"key":" service.name"},"op":"=","value":"api"}]}`,
+			want: []string{`items[0].key.key: attribute name " service.name" has leading or trailing spaces`},
+		},
+		{
+			name: "blank attribute name",
+			raw:  `{"op":"AND","items":[{"key":{"key":"  "},"op":"=","value":"api"}]}`,
+			want: []string{"items[0].key.key: missing attribute name"},
+		},
+		{
+			name: "quoted number",
+			raw:  `{"op":"AND","items":[{"key":{"key":"duration","dataType":"int64"},"op":">","value":"100"}]}`,
+		},
+
+		// IN lists.
+		{name: "in list", raw: `{"op":"AND","items":[{"key":{"key":"env"},"op":"in","value":["prod","staging"]}]}`},
+		{name: "nin single value", raw: `{"op":"AND","items":[{"key":{"key":"env"},"op":"nin","value":"dev"}]}`},
+		{name: "in without value", raw: `{"op":"AND","items":[{"key":{"key":"env"},"op":"IN"}]}`},
+		{name: "empty in list", raw: `{"op":"AND","items":[{"key":{"key":"env"},"op":"in","value":[]}]}`},
+		{
+			name: "list with a single value operator",
+			raw:  `{"op":"AND","items":[{"key":{"key":"env"},"op":"=","value":["prod","staging"]}]}`,
+			want: []string{"items[0].value: operator = expects a single value, use in for a list of values"},
+		},
+		{
+			name: "not in alias",
+			raw:  `{"op":"AND","items":[{"key":{"key":"env"},"op":"not in","value":["dev"]}]}`,
+			want: []string{`items[0].op: unknown operator "not in", did you mean "nin"?`},
+		},
+
+		// Malformed input.
+		{
+			name: "empty object",
+			raw:  `{}`,
+			want: []string{"op: invalid operator <nil>, expected one of: AND, OR", "items: expected a list of filter items"},
+		},
+		{
+			name: "invalid set operator",
+			raw:  `{"op":"XOR","items":[]}`,
+			want: []string{"op: invalid operator XOR, expected one of: AND, OR"},
+		},
+		{
+			name: "numeric set operator",
+			raw:  `{"op":1,"items":null}`,
+			want: []string{"op: invalid operator 1, expected one of: AND, OR", "items: expected a list of filter items"},
+		},
+		{
+			name: "items object",
+			raw:  `{"op":"AND","items":{"key":{"key":"env"},"op":"=","value":"prod"}}`,
+			want: []string{"items: expected a list of filter items"},
+		},
+		{
+			name: "item not an object",
+			raw:  `{"op":"AND","items":["env = prod",null,[]]}`,
+			want: []string{
+				"items[0]: expected an object with key, op and value",
+				"items[1]: expected an object with key, op and value",
+				"items[2]: expected an object with key, op and value",
+			},
+		},
+		{
+			name: "key as a string",
+			raw:  `{"op":"AND","items":[{"key":"env","op":"=","value":"prod"}]}`,
+			want: []string{"items[0].key: expected an object with key, type and dataType"},
+		},
+		{
+			name: "invalid key type and data type",
+			raw:  `{"op":"AND","items":[{"key":{"key":"env","type":"label","dataType":1},"op":"=","value":"prod"}]}`,
+			want: []string{
+				"items[0].key.type: invalid type label, expected one of: tag, resource",
+				"items[0].key.dataType: invalid data type 1, expected one of: string, int64, float64, bool",
+			},
+		},
+		{
+			name: "missing operator",
+			raw:  `{"op":"AND","items":[{"key":{"key":"env"},"value":"prod"},{"key":{"key":"env"},"op":null}]}`,
+			want: []string{"items[0].op: missing operator", "items[1].op: missing operator"},
+		},
+		{
+			name: "misspelled operator",
+			raw:  `{"op":"AND","items":[{"key":{"key":"body"},"op":"contain","value":"error"}]}`,
+			want: []string{`items[0].op: unknown operator "contain", did you mean "contains"?`},
+		},
+		{
+			name: "unknown operator",
+			raw:  `{"op":"AND","items":[{"key":{"key":"body"},"op":"between","value":[1,2]}]}`,
+			want: []string{`items[0].op: unknown operator "between"`},
+		},
+		{
+			name: "missing value",
+			raw:  `{"op":"AND","items":[{"key":{"key":"env"},"op":"="},{"key":{"key":"env"},"op":"like","value":null}]}`,
+			want: []string{"items[0].value: operator = expects a value", "items[1].value: operator like expects a value"},
+		},
+		{name: "exists without value", raw: `{"op":"AND","items":[{"key":{"key":"env"},"op":"nexists"}]}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := validateFilterJSON(t, tc.raw); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("ValidateFilterSet() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSuggestFilterOperator(t *testing.T) {
+	cases := map[string]string{
+		"==":       "=",
+		"<>":       "!=",
+		"NOT_LIKE": "nlike",
+		"=~":       "regex",
+		"regexp":   "regex",
+		"exist":    "exists",
+		"between":  "",
+	}
+
+	for op, want := range cases {
+		if got := suggestFilterOperator(op); got != want {
+			t.Errorf("suggestFilterOperator(%q) = %q, want %q", op, got, want)
+		}
+	}
+}
+
+// FuzzValidateFilterSet ensures malformed filter sets are reported instead of
+// panicking.
+func FuzzValidateFilterSet(f *testing.F) {
+	for _, seed := range []string{
+		`{}`,
+		`{"op":null,"items":[null]}`,
+		`{"op":"AND","items":[{"key":null,"op":1,"value":{}}]}`,
+		`{"op":"AND","items":[{"key":{"key":1,"type":[],"dataType":{}},"op":"in","value":null}]}`,
+		`{"op":"AND","items":[{"key":{"key":"a"},"op":"ééé","value":"x"}]}`,
+		`{"op":"AND","items":[{"key":{},"op":"","value":""}]}`,
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		var filter map[string]interface{}
+		if err := decodeJSON([]byte(raw), &filter); err != nil {
+			return
+		}
+		ValidateFilterSet(filter)
+	})
+}
@@ -0,0 +1,24 @@
+package model
+
+import "encoding/json"
+
+// ObjectID - ID of a SigNoz object, such as a channel or a planned downtime,
+// numeric on older SigNoz versions and a UUID on newer ones.
+type ObjectID string
+
+// UnmarshalJSON - Decodes the ID from either a JSON string or number.
+func (id *ObjectID) UnmarshalJSON(b []byte) error {
+	var value string
+	if err := json.Unmarshal(b, &value); err == nil {
+		*id = ObjectID(value)
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(b, &number); err != nil {
+		return err
+	}
+	*id = ObjectID(number.String())
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// License model.
+type License struct {
+	ID         string           `json:"id,omitempty"`
+	Key        string           `json:"key"`
+	Status     string           `json:"status,omitempty"`
+	Plan       LicensePlan      `json:"plan,omitempty"`
+	ValidFrom  int64            `json:"valid_from,omitempty"`
+	ValidUntil int64            `json:"valid_until,omitempty"`
+	Features   []LicenseFeature `json:"features,omitempty"`
+}
+
+// LicensePlan model.
+type LicensePlan struct {
+	Name string `json:"name"`
+}
+
+// LicenseFeature model.
+type LicenseFeature struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+func (l License) FeaturesToTerraform() (types.List, diag.Diagnostics) {
+	active := utils.Filter(l.Features, func(feature LicenseFeature) bool {
+		return feature.Active
+	})
+	features := utils.Map(active, func(feature LicenseFeature) tfattr.Value {
+		return types.StringValue(feature.Name)
+	})
+
+	return types.ListValue(types.StringType, features)
+}
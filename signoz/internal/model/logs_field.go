@@ -0,0 +1,33 @@
+package model
+
+const (
+	LogsFieldTypeAttributes = "attributes"
+	LogsFieldTypeResources  = "resources"
+
+	LogsFieldDataTypeString  = "string"
+	LogsFieldDataTypeInt64   = "int64"
+	LogsFieldDataTypeFloat64 = "float64"
+	LogsFieldDataTypeBool    = "bool"
+)
+
+//nolint:gochecknoglobals
+var (
+	LogsFieldTypes     = []string{LogsFieldTypeAttributes, LogsFieldTypeResources}
+	LogsFieldDataTypes = []string{LogsFieldDataTypeString, LogsFieldDataTypeInt64, LogsFieldDataTypeFloat64, LogsFieldDataTypeBool}
+)
+
+// LogsFields model.
+type LogsFields struct {
+	Selected    []LogsField `json:"selected"`
+	Interesting []LogsField `json:"interesting"`
+}
+
+// LogsField model.
+type LogsField struct {
+	Name             string `json:"name"`
+	DataType         string `json:"dataType"`
+	Type             string `json:"type"`
+	Selected         bool   `json:"selected"`
+	IndexType        string `json:"indexType,omitempty"`
+	IndexGranularity int64  `json:"indexGranularity,omitempty"`
+}
@@ -0,0 +1,31 @@
+package model
+
+const (
+	MetricTypeGauge                = "Gauge"
+	MetricTypeSum                  = "Sum"
+	MetricTypeHistogram            = "Histogram"
+	MetricTypeSummary              = "Summary"
+	MetricTypeExponentialHistogram = "ExponentialHistogram"
+
+	MetricTemporalityDelta       = "Delta"
+	MetricTemporalityCumulative  = "Cumulative"
+	MetricTemporalityUnspecified = "Unspecified"
+)
+
+//nolint:gochecknoglobals
+var (
+	MetricTypes = []string{
+		MetricTypeGauge, MetricTypeSum, MetricTypeHistogram, MetricTypeSummary, MetricTypeExponentialHistogram,
+	}
+	MetricTemporalities = []string{MetricTemporalityDelta, MetricTemporalityCumulative, MetricTemporalityUnspecified}
+)
+
+// MetricMetadata model.
+type MetricMetadata struct {
+	MetricName  string `json:"metricName"`
+	Type        string `json:"metricType"`
+	Description string `json:"description"`
+	Unit        string `json:"unit"`
+	Temporality string `json:"temporality"`
+	IsMonotonic bool   `json:"isMonotonic"`
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// jsonNull - JSON null literal.
+//
+//nolint:gochecknoglobals
+var jsonNull = []byte("null")
+
+// marshalObject - Marshals the fields of the struct pointed to by v that are
+// set, i.e. not nil, along with its unknown fields. Fields are named after
+// their json tag, and must be pointers, slices or maps. Objects decoded with
+// unmarshalObject marshal back as they were, with the keys sorted.
+func marshalObject(v any, unknown map[string]interface{}) ([]byte, error) {
+	object := make(map[string]interface{}, len(unknown))
+	for key, value := range unknown {
+		object[key] = value
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	for i, name := range objectFields(rv.Type()) {
+		if field := rv.Field(i); name != "" && !field.IsNil() {
+			object[name] = field.Interface()
+		}
+	}
+
+	return json.Marshal(object)
+}
+
+// unmarshalObject - Decodes the known fields of the JSON object into the
+// struct pointed to by v and returns the other fields. Null fields and fields
+// whose value does not fit their type are returned as unknown fields, so that
+// they are kept as they were. Numbers which are not decoded into a typed
+// field keep their literal, so that large integers round trip untouched.
+func unmarshalObject(data []byte, v any) (map[string]interface{}, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	for i, name := range objectFields(rv.Type()) {
+		value, ok := raw[name]
+		if name == "" || !ok || bytes.Equal(bytes.TrimSpace(value), jsonNull) {
+			continue
+		}
+		field := reflect.New(rv.Field(i).Type())
+		if err := decodeJSON(value, field.Interface()); err != nil {
+			continue
+		}
+		rv.Field(i).Set(field.Elem())
+		delete(raw, name)
+	}
+
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	unknown := make(map[string]interface{}, len(raw))
+	for key, value := range raw {
+		var decoded interface{}
+		if err := decodeJSON(value, &decoded); err != nil {
+			return nil, err
+		}
+		unknown[key] = decoded
+	}
+
+	return unknown, nil
+}
+
+// decodeJSON - Decodes the JSON value into v, with the numbers of generic
+// values decoded as json.Number.
+func decodeJSON(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+// objectFields - Returns the JSON names of the fields of the struct type by
+// index. Fields without a name, such as the unknown fields, are empty.
+func objectFields(t reflect.Type) []string {
+	names := make([]string, t.NumField())
+	for i := range names {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "-" {
+			names[i] = name
+		}
+	}
+
+	return names
+}
+
+// equalObjects - Returns true if both values marshal to the same JSON.
+func equalObjects(a, b any) bool {
+	left, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	right, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(left, right)
+}
+
+// objectMap - Returns the value decoded as a generic JSON object, or nil if
+// it is not an object.
+func objectMap(v any) map[string]interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(b, &object); err != nil {
+		return nil
+	}
+
+	return object
+}
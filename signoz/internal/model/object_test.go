@@ -0,0 +1,246 @@
+package model
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// canonicalJSON - Returns the JSON with sorted keys and number literals kept.
+func canonicalJSON(t *testing.T, raw string) string {
+	t.Helper()
+
+	var decoded interface{}
+	if err := decodeJSON([]byte(raw), &decoded); err != nil {
+		t.Fatalf("invalid JSON %s: %v", raw, err)
+	}
+	b, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(b)
+}
+
+func TestObjectRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  string
+	}{
+		{name: "known fields", raw: `{"op":"1","target":10,"matchType":"1","selectedQueryName":"A"}`},
+		{name: "unknown keys", raw: `{"op":"1","requireMinPoints":true,"requiredNumPoints":3,"seasonality":{"period":"1d"}}`},
+		{name: "null known field", raw: `{"op":null,"target":null,"compositeQuery":{"queryType":"builder","unit":null}}`},
+		{name: "type mismatch", raw: `{"target":"10","absentFor":"5m","alertOnAbsent":"yes"}`},
+		{name: "large int64", raw: `{"absentFor":9007199254740993,"compositeQuery":{"builderQueries":{"A":{"stepInterval":9223372036854775807}}}}`},
+		{name: "large unknown integer", raw: `{"evalWindowNanos":9007199254740993123,"ratio":0.1000000000000000055511151231257827}`},
+		{
+			name: "nested unknown keys",
+			raw: `{"compositeQuery":{"queryType":"builder","fillGaps":true,"builderQueries":{"A":{"queryName":"A",` +
+				`"functions":[{"name":"timeShift","args":[3600]}]}}},"thresholds":{"kind":"basic","spec":[{"name":"critical",` +
+				`"target":1,"recoveryTarget":null,"channels":["pagerduty"],"evaluation":"rolling"}]}}`,
+		},
+		{name: "empty", raw: `{}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var condition Condition
+			if err := json.Unmarshal([]byte(tc.raw), &condition); err != nil {
+				t.Fatalf("Unmarshal() = %v", err)
+			}
+			b, err := json.Marshal(condition)
+			if err != nil {
+				t.Fatalf("Marshal() = %v", err)
+			}
+			if got, want := string(b), canonicalJSON(t, tc.raw); got != want {
+				t.Fatalf("round trip changed the condition\n got: %s\nwant: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestObjectUnknownFields(t *testing.T) {
+	cases := []struct {
+		name        string
+		raw         string
+		wantUnknown []string
+		check       func(t *testing.T, c Condition)
+	}{
+		{
+			name:        "unknown keys are kept",
+			raw:         `{"op":"1","requireMinPoints":true}`,
+			wantUnknown: []string{"requireMinPoints"},
+			check: func(t *testing.T, c Condition) {
+				if c.Op == nil || *c.Op != "1" {
+					t.Errorf("Op = %v, want 1", c.Op)
+				}
+			},
+		},
+		{
+			name:        "null known fields stay unknown",
+			raw:         `{"op":null,"target":null}`,
+			wantUnknown: []string{"op", "target"},
+			check: func(t *testing.T, c Condition) {
+				if c.Op != nil || c.Target != nil {
+					t.Errorf("Op = %v, Target = %v, want nil", c.Op, c.Target)
+				}
+			},
+		},
+		{
+			name:        "type-mismatched values are kept verbatim",
+			raw:         `{"target":"10","absentFor":1.5}`,
+			wantUnknown: []string{"absentFor", "target"},
+			check: func(t *testing.T, c Condition) {
+				if c.Unknown["target"] != "10" {
+					t.Errorf("Unknown[target] = %#v, want \"10\"", c.Unknown["target"])
+				}
+				if c.Unknown["absentFor"] != json.Number("1.5") {
+					t.Errorf("Unknown[absentFor] = %#v, want 1.5", c.Unknown["absentFor"])
+				}
+			},
+		},
+		{
+			name:        "large int64 literals are decoded exactly",
+			raw:         `{"absentFor":9007199254740993,"other":9007199254740993}`,
+			wantUnknown: []string{"other"},
+			check: func(t *testing.T, c Condition) {
+				if c.AbsentFor == nil || *c.AbsentFor != 9007199254740993 {
+					t.Errorf("AbsentFor = %v, want 9007199254740993", c.AbsentFor)
+				}
+				if c.Unknown["other"] != json.Number("9007199254740993") {
+					t.Errorf("Unknown[other] = %#v, want the literal", c.Unknown["other"])
+				}
+			},
+		},
+		{
+			name: "no unknown fields",
+			raw:  `{"op":"1"}`,
+			check: func(t *testing.T, c Condition) {
+				if c.Unknown != nil {
+					t.Errorf("Unknown = %v, want nil", c.Unknown)
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var condition Condition
+			if err := json.Unmarshal([]byte(tc.raw), &condition); err != nil {
+				t.Fatalf("Unmarshal() = %v", err)
+			}
+			var unknown []string
+			for key := range condition.Unknown {
+				unknown = append(unknown, key)
+			}
+			if got := sortedStrings(unknown); !reflect.DeepEqual(got, sortedStrings(tc.wantUnknown)) {
+				t.Errorf("unknown fields = %v, want %v", got, tc.wantUnknown)
+			}
+			tc.check(t, condition)
+		})
+	}
+}
+
+func TestObjectInvalidJSON(t *testing.T) {
+	for _, raw := range []string{`[]`, `"op"`, `{"op":`, `nope`} {
+		var condition Condition
+		if err := json.Unmarshal([]byte(raw), &condition); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded, want an error", raw)
+		}
+	}
+}
+
+// objectTypes - The types marshaled with marshalObject, by name.
+//
+//nolint:gochecknoglobals
+var objectTypes = map[string]any{
+	"BuilderQuery":        BuilderQuery{},
+	"ChQuery":             ChQuery{},
+	"CompositeQuery":      CompositeQuery{},
+	"Condition":           Condition{},
+	"ConditionThresholds": ConditionThresholds{},
+	"DashboardPanel":      DashboardPanel{},
+	"LayoutItem":          LayoutItem{},
+	"PromQuery":           PromQuery{},
+	"Threshold":           Threshold{},
+	"Widget":              Widget{},
+	"WidgetQuery":         WidgetQuery{},
+}
+
+// TestObjectTypesAreListed guards that objectTypes lists every type whose
+// MarshalJSON calls marshalObject, so that TestObjectFieldsAreNilable covers
+// the types added later.
+func TestObjectTypesAreListed(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Name.Name != "MarshalJSON" || !callsMarshalObject(fn) {
+				continue
+			}
+			recv := fn.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			name := recv.(*ast.Ident).Name
+			if _, ok := objectTypes[name]; !ok {
+				t.Errorf("%s: %s is marshaled with marshalObject but missing from objectTypes", file, name)
+			}
+		}
+	}
+}
+
+// TestObjectFieldsAreNilable guards that every named field of the types
+// marshaled with marshalObject can be nil, as marshalObject only marshals
+// the fields which are set.
+func TestObjectFieldsAreNilable(t *testing.T) {
+	for name, object := range objectTypes {
+		rt := reflect.TypeOf(object)
+		for i, field := range objectFields(rt) {
+			if field == "" {
+				continue
+			}
+			switch kind := rt.Field(i).Type.Kind(); kind {
+			case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+			default:
+				t.Errorf("%s.%s is a %s, but fields of objects must be pointers, slices or maps", name, rt.Field(i).Name, kind)
+			}
+		}
+	}
+}
+
+// callsMarshalObject - Returns true if the function calls marshalObject.
+func callsMarshalObject(fn *ast.FuncDecl) bool {
+	found := false
+	ast.Inspect(fn, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "marshalObject" {
+				found = true
+			}
+		}
+		return !found
+	})
+
+	return found
+}
+
+func sortedStrings(values []string) []string {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+
+	return sorted
+}
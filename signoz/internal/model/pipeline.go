@@ -0,0 +1,93 @@
+package model
+
+const (
+	PipelineProcessorGrokParser     = "grok_parser"
+	PipelineProcessorRegexParser    = "regex_parser"
+	PipelineProcessorJSONParser     = "json_parser"
+	PipelineProcessorTraceParser    = "trace_parser"
+	PipelineProcessorAdd            = "add"
+	PipelineProcessorRemove         = "remove"
+	PipelineProcessorMove           = "move"
+	PipelineProcessorCopy           = "copy"
+	PipelineProcessorSeverityParser = "severity_parser"
+
+	PipelineOnErrorSend = "send"
+	PipelineOnErrorDrop = "drop"
+
+	PipelineDeployStatusDeployed = "DEPLOYED"
+	PipelineDeployStatusFailed   = "FAILED"
+)
+
+//nolint:gochecknoglobals
+var (
+	PipelineProcessorTypes = []string{
+		PipelineProcessorGrokParser, PipelineProcessorRegexParser, PipelineProcessorJSONParser,
+		PipelineProcessorTraceParser, PipelineProcessorAdd, PipelineProcessorRemove,
+		PipelineProcessorMove, PipelineProcessorCopy, PipelineProcessorSeverityParser,
+	}
+	PipelineOnErrors = []string{PipelineOnErrorSend, PipelineOnErrorDrop}
+)
+
+// Pipelines model. The pipelines API saves all pipelines at once. The other
+// fields describe the deployment of the version and are only read.
+type Pipelines struct {
+	PipelinesVersion
+	Pipelines []Pipeline         `json:"pipelines"`
+	History   []PipelinesVersion `json:"history,omitempty"`
+}
+
+// PipelinesVersion model. Every save of the pipelines creates a version,
+// deployed to the collectors by SigNoz.
+type PipelinesVersion struct {
+	Version       int    `json:"version,omitempty"`
+	DeployStatus  string `json:"deployStatus,omitempty"`
+	DeployResult  string `json:"deployResult,omitempty"`
+	CreatedAt     string `json:"createdAt,omitempty"`
+	CreatedBy     string `json:"createdBy,omitempty"`
+	CreatedByName string `json:"createdByName,omitempty"`
+}
+
+// Pipeline model.
+type Pipeline struct {
+	ID          string                 `json:"id,omitempty"`
+	OrderID     int                    `json:"orderId"`
+	Name        string                 `json:"name"`
+	Alias       string                 `json:"alias"`
+	Description string                 `json:"description"`
+	Enabled     bool                   `json:"enabled"`
+	Filter      map[string]interface{} `json:"filter"`
+	Config      []PipelineOperator     `json:"config"`
+}
+
+// PipelineOperator model.
+type PipelineOperator struct {
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Name    string `json:"name,omitempty"`
+	OrderID int    `json:"orderId"`
+	Enabled bool   `json:"enabled"`
+	Output  string `json:"output,omitempty"`
+	OnError string `json:"on_error,omitempty"`
+
+	ParseFrom string `json:"parse_from,omitempty"`
+	ParseTo   string `json:"parse_to,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+	Regex     string `json:"regex,omitempty"`
+
+	TraceID    *PipelineParseFrom `json:"trace_id,omitempty"`
+	SpanID     *PipelineParseFrom `json:"span_id,omitempty"`
+	TraceFlags *PipelineParseFrom `json:"trace_flags,omitempty"`
+
+	Field string `json:"field,omitempty"`
+	Value string `json:"value,omitempty"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+
+	Mapping       map[string][]string `json:"mapping,omitempty"`
+	OverwriteText bool                `json:"overwrite_text,omitempty"`
+}
+
+// PipelineParseFrom model.
+type PipelineParseFrom struct {
+	ParseFrom string `json:"parse_from"`
+}
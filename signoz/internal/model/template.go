@@ -0,0 +1,46 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// alertTemplatePreamble - Variables SigNoz defines before expanding the
+// labels and annotations of alerts, as in Prometheus.
+const alertTemplatePreamble = "{{$labels := .Labels}}{{$value := .Value}}{{$threshold := .Threshold}}"
+
+// alertTemplateFuncs - Functions available to the templates of alerts. Only
+// their names matter to parse the templates.
+//
+//nolint:gochecknoglobals
+var alertTemplateFuncs = func() template.FuncMap {
+	funcs := template.FuncMap{}
+	for _, name := range []string{
+		"args", "externalURL", "first", "graphLink", "humanize", "humanize1024", "humanizeDuration",
+		"humanizePercentage", "humanizeTimestamp", "label", "match", "parseDuration", "pathPrefix", "query",
+		"reReplaceAll", "safeHtml", "sortByLabel", "stripDomain", "stripPort", "tableLink", "title", "tmpl",
+		"toLower", "toTime", "toUpper", "urlUnescape", "value",
+	} {
+		funcs[name] = func(...any) any { return nil }
+	}
+
+	return funcs
+}()
+
+// ValidateAlertTemplate - Returns an error if the label or annotation value
+// is not a valid template, such as {{ $value }} or {{ .Labels.service }}.
+// Values without template actions are always valid.
+func ValidateAlertTemplate(value string) error {
+	if !strings.Contains(value, "{{") {
+		return nil
+	}
+
+	_, err := template.New("alert").Funcs(alertTemplateFuncs).Option("missingkey=zero").Parse(alertTemplatePreamble + value)
+	if err != nil {
+		// The error refers to the template name, which is meaningless to users.
+		return fmt.Errorf("invalid template: %s", strings.TrimPrefix(err.Error(), "template: alert:1: "))
+	}
+
+	return nil
+}
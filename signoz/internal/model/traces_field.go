@@ -0,0 +1,8 @@
+package model
+
+// TracesFields model. The span fields API shares the payload of the log
+// fields API, including the field types and data types.
+type TracesFields = LogsFields
+
+// TracesField model.
+type TracesField = LogsField
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+)
+
+// unit - Unit of the y-axis or the target of an alert, converted to the other
+// units of its kind, such as duration, through its factor to the base unit of
+// the kind.
+type unit struct {
+	kind   string
+	factor float64
+}
+
+//nolint:gochecknoglobals
+var (
+	// units maps the IDs of the units known to SigNoz to their kind and factor,
+	// e.g. seconds for durations and bits for data.
+	units = map[string]unit{
+		// Durations, in seconds.
+		"ns": {"duration", 1e-9},
+		"µs": {"duration", 1e-6},
+		"ms": {"duration", 1e-3},
+		"s":  {"duration", 1},
+		"m":  {"duration", 60},
+		"h":  {"duration", 3600},
+		"d":  {"duration", 86400},
+
+		// Data, in bits, with IEC and SI multiples.
+		"bits":      {"data size", 1},
+		"decbits":   {"data size", 1},
+		"bytes":     {"data size", 8},
+		"kbytes":    {"data size", 8 << 10},
+		"mbytes":    {"data size", 8 << 20},
+		"gbytes":    {"data size", 8 << 30},
+		"tbytes":    {"data size", 8 << 40},
+		"pbytes":    {"data size", 8 << 50},
+		"decbytes":  {"data size", 8},
+		"deckbytes": {"data size", 8e3},
+		"decmbytes": {"data size", 8e6},
+		"decgbytes": {"data size", 8e9},
+		"dectbytes": {"data size", 8e12},
+		"decpbytes": {"data size", 8e15},
+
+		// Data rates, in bits per second.
+		"bps":   {"data rate", 1},
+		"Kbits": {"data rate", 1e3},
+		"Mbits": {"data rate", 1e6},
+		"Gbits": {"data rate", 1e9},
+		"Bps":   {"data rate", 8},
+		"KBs":   {"data rate", 8e3},
+		"MBs":   {"data rate", 8e6},
+		"GBs":   {"data rate", 8e9},
+
+		// Throughputs, per second.
+		"cps":   {"count rate", 1},
+		"cpm":   {"count rate", 1.0 / 60},
+		"ops":   {"operation rate", 1},
+		"opm":   {"operation rate", 1.0 / 60},
+		"rps":   {"read rate", 1},
+		"rpm":   {"read rate", 1.0 / 60},
+		"wps":   {"write rate", 1},
+		"wpm":   {"write rate", 1.0 / 60},
+		"reqps": {"request rate", 1},
+		"iops":  {"IO rate", 1},
+
+		// Ratios, in percent.
+		"percent":     {"ratio", 1},
+		"percentunit": {"ratio", 100},
+
+		// Plain numbers.
+		"none":  {"plain number", 1},
+		"short": {"plain number", 1},
+	}
+
+	// Units are the IDs of the units known to SigNoz, sorted.
+	Units = utils.SortedKeys(units)
+)
+
+// CompatibleUnits - Returns an error if a value in the unit cannot be
+// compared with a value in the other unit, e.g. a target in ms with a query
+// returning bytes. Empty units are compatible with any unit.
+func CompatibleUnits(from, to string) error {
+	if from == "" || to == "" {
+		return nil
+	}
+
+	fromUnit, ok := units[from]
+	if !ok {
+		return fmt.Errorf("unknown unit %q", from)
+	}
+	toUnit, ok := units[to]
+	if !ok {
+		return fmt.Errorf("unknown unit %q", to)
+	}
+	if fromUnit.kind != toUnit.kind {
+		return fmt.Errorf("unit %q (%s) cannot be converted to unit %q (%s)", from, fromUnit.kind, to, toUnit.kind)
+	}
+
+	return nil
+}
+
+// ConvertUnit - Converts the value from the unit to the other unit of the
+// same kind, e.g. 1.5 s to 1500 ms.
+func ConvertUnit(value float64, from, to string) (float64, error) {
+	if from == "" || to == "" {
+		return 0, fmt.Errorf("both units are required")
+	}
+	if err := CompatibleUnits(from, to); err != nil {
+		return 0, err
+	}
+
+	return value * units[from].factor / units[to].factor, nil
+}
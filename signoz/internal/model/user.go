@@ -0,0 +1,64 @@
+package model
+
+import "time"
+
+const (
+	UserRoleAdmin  = "ADMIN"
+	UserRoleEditor = "EDITOR"
+	UserRoleViewer = "VIEWER"
+)
+
+const (
+	UserPermissionRead   = "read"
+	UserPermissionWrite  = "write"
+	UserPermissionManage = "manage"
+)
+
+//nolint:gochecknoglobals
+var (
+	UserRoles = []string{UserRoleAdmin, UserRoleEditor, UserRoleViewer}
+
+	// UserRoleDescriptions describe the built-in roles of SigNoz.
+	UserRoleDescriptions = map[string]string{
+		UserRoleAdmin:  "Manages the organization, including users, roles, API keys, SSO and the license, in addition to editing.",
+		UserRoleEditor: "Creates and edits dashboards, alerts, channels, pipelines and saved views, in addition to viewing.",
+		UserRoleViewer: "Views dashboards, alerts and the explorers.",
+	}
+	// UserRolePermissions are the permissions of the built-in roles of
+	// SigNoz. SigNoz does not expose them through its API.
+	UserRolePermissions = map[string][]string{
+		UserRoleAdmin:  {UserPermissionRead, UserPermissionWrite, UserPermissionManage},
+		UserRoleEditor: {UserPermissionRead, UserPermissionWrite},
+		UserRoleViewer: {UserPermissionRead},
+	}
+)
+
+// UserRole model.
+type UserRole struct {
+	UserID    string `json:"user_id,omitempty"`
+	GroupName string `json:"group_name"`
+}
+
+// User model.
+type User struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Role  string `json:"role"`
+	// CreatedAt is a Unix timestamp in seconds on older SigNoz versions and
+	// an RFC 3339 timestamp on newer ones.
+	CreatedAt any `json:"createdAt"`
+}
+
+// CreatedAtString - Returns the creation time of the user as an RFC 3339
+// timestamp, or an empty string if unknown.
+func (u User) CreatedAtString() string {
+	switch createdAt := u.CreatedAt.(type) {
+	case float64:
+		return time.Unix(int64(createdAt), 0).UTC().Format(time.RFC3339)
+	case string:
+		return createdAt
+	default:
+		return ""
+	}
+}
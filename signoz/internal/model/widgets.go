@@ -0,0 +1,328 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+)
+
+const (
+	// widgetGridColumns - Number of columns of the dashboard grid.
+	widgetGridColumns = 12
+	// widgetDefaultSize - Width and height of widgets placed without a layout.
+	widgetDefaultSize = 6
+)
+
+// widgetPlaceholder - Placeholder of a variable in a widget template, such as
+// [[service]]. It differs from the {{.variable}} syntax of dashboard variables,
+// which SigNoz resolves itself.
+//
+//nolint:gochecknoglobals
+var widgetPlaceholder = regexp.MustCompile(`\[\[([A-Za-z_][A-Za-z0-9_]*)\]\]`)
+
+// Widget - Widget of a dashboard. Fields the provider does not model are
+// kept in Unknown, so that widgets marshal back as they were.
+type Widget struct {
+	ID          *string                `json:"id"`
+	PanelTypes  *string                `json:"panelTypes"`
+	Title       *string                `json:"title"`
+	Description *string                `json:"description"`
+	Query       *WidgetQuery           `json:"query"`
+	Unknown     map[string]interface{} `json:"-"`
+}
+
+// WidgetQuery - Query envelope of a widget, holding the query of each type.
+type WidgetQuery struct {
+	ID            *string                `json:"id"`
+	QueryType     *string                `json:"queryType"`
+	Builder       map[string]interface{} `json:"builder"`
+	PromQL        []interface{}          `json:"promql"`
+	ClickHouseSQL []interface{}          `json:"clickhouse_sql"`
+	Unknown       map[string]interface{} `json:"-"`
+}
+
+// LayoutItem - Position of a widget on the dashboard grid.
+type LayoutItem struct {
+	I       *string                `json:"i"`
+	X       *float64               `json:"x"`
+	Y       *float64               `json:"y"`
+	W       *float64               `json:"w"`
+	H       *float64               `json:"h"`
+	Moved   *bool                  `json:"moved"`
+	Static  *bool                  `json:"static"`
+	Unknown map[string]interface{} `json:"-"`
+}
+
+// DashboardPanel - Collapsible row of the panel map, with the layout of the
+// widgets it groups.
+type DashboardPanel struct {
+	Collapsed *bool                  `json:"collapsed"`
+	Widgets   []*LayoutItem          `json:"widgets"`
+	Unknown   map[string]interface{} `json:"-"`
+}
+
+func (w Widget) MarshalJSON() ([]byte, error) { return marshalObject(&w, w.Unknown) }
+
+func (w *Widget) UnmarshalJSON(data []byte) (err error) {
+	w.Unknown, err = unmarshalObject(data, w)
+	return err
+}
+
+// Equal - Returns true if both widgets marshal to the same JSON.
+func (w *Widget) Equal(other *Widget) bool { return equalObjects(w, other) }
+
+// GetID - Returns the ID of the widget, or an empty string if it has none.
+func (w *Widget) GetID() string {
+	if w == nil || w.ID == nil {
+		return ""
+	}
+
+	return *w.ID
+}
+
+func (q WidgetQuery) MarshalJSON() ([]byte, error) { return marshalObject(&q, q.Unknown) }
+
+func (q *WidgetQuery) UnmarshalJSON(data []byte) (err error) {
+	q.Unknown, err = unmarshalObject(data, q)
+	return err
+}
+
+// Equal - Returns true if both widget queries marshal to the same JSON.
+func (q *WidgetQuery) Equal(other *WidgetQuery) bool { return equalObjects(q, other) }
+
+func (l LayoutItem) MarshalJSON() ([]byte, error) { return marshalObject(&l, l.Unknown) }
+
+func (l *LayoutItem) UnmarshalJSON(data []byte) (err error) {
+	l.Unknown, err = unmarshalObject(data, l)
+	return err
+}
+
+// Equal - Returns true if both layout items marshal to the same JSON.
+func (l *LayoutItem) Equal(other *LayoutItem) bool { return equalObjects(l, other) }
+
+// GetID - Returns the ID of the widget placed by the item, or an empty string
+// if it has none.
+func (l *LayoutItem) GetID() string {
+	if l == nil || l.I == nil {
+		return ""
+	}
+
+	return *l.I
+}
+
+func (p DashboardPanel) MarshalJSON() ([]byte, error) { return marshalObject(&p, p.Unknown) }
+
+func (p *DashboardPanel) UnmarshalJSON(data []byte) (err error) {
+	p.Unknown, err = unmarshalObject(data, p)
+	return err
+}
+
+// IsCollapsed - Returns true if the row is collapsed.
+func (p *DashboardPanel) IsCollapsed() bool {
+	return p != nil && p.Collapsed != nil && *p.Collapsed
+}
+
+// WidgetSource - Widgets of a dashboard with their optional layout.
+type WidgetSource struct {
+	Widgets []*Widget     `json:"widgets"`
+	Layout  []*LayoutItem `json:"layout"`
+}
+
+// ParseWidgetSource - Parses either an array of widgets or an object with
+// widgets and layout arrays.
+func ParseWidgetSource(value string) (*WidgetSource, error) {
+	var source WidgetSource
+	if strings.HasPrefix(strings.TrimSpace(value), "[") {
+		if err := json.Unmarshal([]byte(value), &source.Widgets); err != nil {
+			return nil, err
+		}
+		return &source, nil
+	}
+
+	if err := json.Unmarshal([]byte(value), &source); err != nil {
+		return nil, err
+	}
+
+	return &source, nil
+}
+
+// MergeWidgets - Merges the widgets of the sources in order. Widgets sharing
+// an ID are deep merged, with later sources taking precedence, and keep the
+// position of their first occurrence. The layout of each source is moved
+// below the previous sources, and widgets without a layout are placed in
+// rows of two.
+func MergeWidgets(sources []WidgetSource) ([]*Widget, []*LayoutItem, error) {
+	widgets := make([]*Widget, 0)
+	layout := make([]*LayoutItem, 0)
+	widgetsByID := map[string]*Widget{}
+	rowOffset := 0.0
+
+	for n, source := range sources {
+		added := map[string]bool{}
+		for _, widget := range source.Widgets {
+			id := widget.GetID()
+			if id == "" {
+				return nil, nil, fmt.Errorf("widget without id in source %d", n+1)
+			}
+			if existing, ok := widgetsByID[id]; ok {
+				if err := mergeWidget(existing, widget); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+			widgetsByID[id] = widget
+			widgets = append(widgets, widget)
+			added[id] = true
+		}
+
+		bottom := 0.0
+		placed := map[string]bool{}
+		for _, item := range source.Layout {
+			id := item.GetID()
+			if !added[id] || placed[id] {
+				continue
+			}
+			moved := *item
+			y, h := floatValue(item.Y), floatValue(item.H)
+			moved.Y = utils.Ptr(y + rowOffset)
+			bottom = max(bottom, y+h)
+			layout = append(layout, &moved)
+			placed[id] = true
+		}
+
+		unplaced := 0
+		for _, widget := range source.Widgets {
+			id := widget.GetID()
+			if !added[id] || placed[id] {
+				continue
+			}
+			layout = append(layout, &LayoutItem{
+				I: utils.Ptr(id),
+				X: utils.Ptr(float64((unplaced % 2) * widgetDefaultSize)),
+				Y: utils.Ptr(rowOffset + bottom + float64((unplaced/2)*widgetDefaultSize)),
+				W: utils.Ptr(float64(widgetDefaultSize)),
+				H: utils.Ptr(float64(widgetDefaultSize)),
+			})
+			placed[id] = true
+			unplaced++
+		}
+		bottom += float64((unplaced + 1) / 2 * widgetDefaultSize)
+
+		rowOffset += bottom
+	}
+
+	for _, item := range layout {
+		if item.W != nil && *item.W > widgetGridColumns {
+			item.W = utils.Ptr(float64(widgetGridColumns))
+		}
+	}
+
+	return widgets, layout, nil
+}
+
+// mergeWidget - Deep merges src into dst.
+func mergeWidget(dst, src *Widget) error {
+	merged := objectMap(dst)
+	deepMerge(merged, objectMap(src))
+
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	*dst = Widget{}
+
+	return json.Unmarshal(b, dst)
+}
+
+// floatValue - Returns the value, or 0 if it is not set.
+func floatValue(value *float64) float64 {
+	if value == nil {
+		return 0
+	}
+
+	return *value
+}
+
+// deepMerge - Merges src into dst. Nested objects are merged, other values replaced.
+func deepMerge(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcOK := value.(map[string]interface{})
+		dstMap, dstOK := dst[key].(map[string]interface{})
+		if srcOK && dstOK {
+			deepMerge(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// RenderWidget - Instantiates the widget template with the ID, replacing the
+// placeholders in its string values with the variables. Placeholders without
+// a variable are reported as an error.
+func RenderWidget(template, id string, variables map[string]string) (*Widget, error) {
+	var widget map[string]interface{}
+	if err := json.Unmarshal([]byte(template), &widget); err != nil {
+		return nil, err
+	}
+	if widget == nil {
+		return nil, errors.New("widget template is not an object")
+	}
+
+	missing := map[string]bool{}
+	substituted, _ := substitute(widget, variables, missing).(map[string]interface{})
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("missing widget variables: %s", strings.Join(names, ", "))
+	}
+
+	b, err := json.Marshal(substituted)
+	if err != nil {
+		return nil, err
+	}
+	var rendered Widget
+	if err := json.Unmarshal(b, &rendered); err != nil {
+		return nil, err
+	}
+	rendered.ID = utils.Ptr(id)
+
+	return &rendered, nil
+}
+
+// substitute - Returns a copy of the value with the placeholders of its strings
+// replaced, recording the placeholders without a variable.
+func substitute(value interface{}, variables map[string]string, missing map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = substitute(item, variables, missing)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = substitute(item, variables, missing)
+		}
+		return result
+	case string:
+		return widgetPlaceholder.ReplaceAllStringFunc(v, func(placeholder string) string {
+			name := widgetPlaceholder.FindStringSubmatch(placeholder)[1]
+			replacement, ok := variables[name]
+			if !ok {
+				missing[name] = true
+			}
+			return replacement
+		})
+	default:
+		return v
+	}
+}
@@ -0,0 +1,131 @@
+package normalize
+
+import (
+	"reflect"
+	"sort"
+)
+
+// alertConditionRules - Default values SigNoz adds to alert conditions, by the
+// key of the field, which also names the rule removing it. The defaults cause
+// drift when compared with the configuration. For instance, alertOnAbsent is
+// false and absentFor is 0 unless absent-data alerting is enabled, through the
+// condition or the alert_on_absent and absent_for attributes of signoz_alert.
+//
+//nolint:gochecknoglobals
+var alertConditionRules = map[string]func(value any) bool{
+	"groupBy": func(value any) bool {
+		// Check if it's an empty slice
+		if slice, ok := value.([]any); ok {
+			return len(slice) == 0
+		}
+		return false
+	},
+	"IsAnomaly":            func(value any) bool { return value == false },
+	"QueriesUsedInFormula": func(value any) bool { return value == nil },
+	"absentFor":            func(value any) bool { return value == float64(0) }, // Decoded numbers are float64.
+	"alertOnAbsent":        func(value any) bool { return value == false },
+	"hidden":               func(value any) bool { return value == true },
+	"reduceTo":             isEmptyString,
+	"spaceAggregation":     isEmptyString,
+	"timeAggregation":      isEmptyString,
+}
+
+// alertConditionObjects - Paths of the objects of alert conditions holding the
+// default fields, i.e. the condition, the v3 and v4 builder queries, and the
+// v5 query specs and their aggregations.
+//
+//nolint:gochecknoglobals
+var alertConditionObjects = []string{
+	"",
+	"compositeQuery.builderQueries.*",
+	"compositeQuery.queries.spec",
+	"compositeQuery.queries.spec.aggregations",
+}
+
+// AlertConditionScope - Returns the paths of the objects of alert conditions
+// the defaults apply to, for Options.Scope, under the path of the condition,
+// such as condition in alert payloads. Unknown fields elsewhere are compared
+// as they are.
+func AlertConditionScope(prefix string) []string {
+	scope := make([]string, 0, len(alertConditionObjects))
+	for _, path := range alertConditionObjects {
+		switch {
+		case prefix == "":
+			scope = append(scope, path)
+		case path == "":
+			scope = append(scope, prefix)
+		default:
+			scope = append(scope, prefix+"."+path)
+		}
+	}
+
+	return scope
+}
+
+// AlertConditionDefaults - Identifies the default fields SigNoz adds to alert
+// conditions.
+func AlertConditionDefaults(key string, value any) bool {
+	isDefault, ok := alertConditionRules[key]
+
+	return ok && isDefault(value)
+}
+
+// AlertConditionRules - Returns the sorted names of the rules removing the
+// default fields of alert conditions, which can be disabled with
+// Options.Disabled.
+func AlertConditionRules() []string {
+	rules := make([]string, 0, len(alertConditionRules))
+	for rule := range alertConditionRules {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+
+	return rules
+}
+
+// AlertConditionThresholds - Removes the thresholds SigNoz derives from the
+// single target of legacy conditions, i.e. a single basic threshold holding
+// the target, op, matchType and targetUnit of the condition, so that legacy
+// conditions compare equal to the ones stored by servers supporting
+// thresholds. The value is not modified.
+func AlertConditionThresholds(data any) any {
+	condition, ok := data.(map[string]any)
+	if !ok || condition["target"] == nil {
+		return data
+	}
+	thresholds, ok := condition["thresholds"].(map[string]any)
+	if !ok || thresholds["kind"] != "basic" {
+		return data
+	}
+	spec, _ := thresholds["spec"].([]any)
+	if len(spec) != 1 {
+		return data
+	}
+	threshold, ok := spec[0].(map[string]any)
+	if !ok || threshold["recoveryTarget"] != nil {
+		return data
+	}
+	for _, key := range []string{"target", "op", "matchType", "targetUnit"} {
+		if !reflect.DeepEqual(threshold[key], condition[key]) && !(isEmptyValue(threshold[key]) && isEmptyValue(condition[key])) {
+			return data
+		}
+	}
+
+	result := make(map[string]any, len(condition))
+	for key, value := range condition {
+		if key != "thresholds" {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+// isEmptyValue - Returns true if the value is missing or an empty string.
+func isEmptyValue(value any) bool {
+	return value == nil || value == ""
+}
+
+func isEmptyString(value any) bool {
+	return value == ""
+}
@@ -0,0 +1,65 @@
+package normalize
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Cache - Normalized forms of JSON strings, so that the same condition is not
+// normalized again across plan modifiers, reads and updates. It is meant to
+// live as long as the provider, i.e. one plan or apply. Safe for concurrent use.
+type Cache struct {
+	mu      sync.Mutex
+	results map[string]*Result
+}
+
+// NewCache - Creates an empty cache.
+func NewCache() *Cache {
+	return &Cache{results: map[string]*Result{}}
+}
+
+// get - Returns the cached normalized form of the JSON string, if any.
+func (c *Cache) get(key string) (*Result, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result, ok := c.results[key]
+
+	return result, ok
+}
+
+// put - Caches the normalized form of the JSON string.
+func (c *Cache) put(key string, result *Result) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.results[key] = result
+}
+
+// cacheKey - Returns the hash of the JSON string along with the options that
+// affect its normalization. The defaults and the transform are identified by
+// the subsystem, less the disabled defaults and within their scope.
+func cacheKey(raw string, opts Options) string {
+	hash := sha256.New()
+	hash.Write([]byte(opts.Subsystem))
+	hash.Write([]byte{0})
+	// The lengths tell an empty list from a list of an empty string, such as
+	// the scope of the root from no scope.
+	for _, list := range [][]string{opts.Ignore, opts.Disabled, opts.Scope} {
+		fmt.Fprintf(hash, "%d\x00%s\x00", len(list), strings.Join(list, "\x00"))
+	}
+	hash.Write([]byte(raw))
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
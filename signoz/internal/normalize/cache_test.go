@@ -0,0 +1,153 @@
+package normalize
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+// countingOptions - Options counting the values normalized, i.e. the cache
+// misses.
+func countingOptions(cache *Cache, count *int) Options {
+	var mu sync.Mutex
+	return Options{
+		Defaults: AlertConditionDefaults,
+		Transform: func(data any) any {
+			mu.Lock()
+			defer mu.Unlock()
+			*count++
+			return data
+		},
+		Cache: cache,
+	}
+}
+
+func TestCacheHit(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache()
+	var count int
+	opts := countingOptions(cache, &count)
+
+	first, err := JSON(ctx, `{"a":1,"hidden":true}`, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := JSON(ctx, `{"a":1,"hidden":true}`, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("normalized %d times, want 1", count)
+	}
+	if second != first {
+		t.Fatalf("JSON() = %+v, want the cached %+v", second, first)
+	}
+
+	// Compare normalizes both sides through the cache.
+	comparison, err := Compare(ctx, `{"a":1,"hidden":true}`, `{"a":1}`, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !comparison.Equal || count != 2 {
+		t.Fatalf("Compare() = %t after %d normalizations, want true after 2", comparison.Equal, count)
+	}
+
+	// A differently formatted string is a different key.
+	if _, err := JSON(ctx, `{"a": 1, "hidden": true}`, opts); err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Fatalf("normalized %d times, want 3", count)
+	}
+}
+
+func TestCacheKeyOptions(t *testing.T) {
+	raw := `{"a":1,"hidden":true,"id":"x"}`
+	base := Options{Subsystem: SubsystemAlertCondition}
+
+	cases := []struct {
+		name string
+		opts Options
+	}{
+		{name: "subsystem", opts: Options{Subsystem: SubsystemDashboard}},
+		{name: "ignore", opts: Options{Subsystem: SubsystemAlertCondition, Ignore: []string{"id"}}},
+		{name: "disabled", opts: Options{Subsystem: SubsystemAlertCondition, Disabled: []string{"hidden"}}},
+		{name: "scope", opts: Options{Subsystem: SubsystemAlertCondition, Scope: []string{""}}},
+		{name: "ignore and disabled", opts: Options{Subsystem: SubsystemAlertCondition, Ignore: []string{"a"}, Disabled: []string{"b"}}},
+	}
+
+	keys := map[string]string{cacheKey(raw, base): "base"}
+	for _, tc := range cases {
+		key := cacheKey(raw, tc.opts)
+		if other, ok := keys[key]; ok {
+			t.Errorf("%s has the cache key of %s", tc.name, other)
+		}
+		keys[key] = tc.name
+	}
+
+	// The patterns are not concatenated ambiguously.
+	if cacheKey(raw, Options{Ignore: []string{"a", "b"}}) == cacheKey(raw, Options{Ignore: []string{"ab"}}) {
+		t.Errorf("different ignored patterns have the same cache key")
+	}
+	if cacheKey(raw, base) != cacheKey(raw, Options{Subsystem: SubsystemAlertCondition}) {
+		t.Errorf("the cache key is not stable")
+	}
+}
+
+func TestCacheDisabledRules(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache()
+	var count int
+	opts := countingOptions(cache, &count)
+
+	raw := `{"hidden":true}`
+	result, err := JSON(ctx, raw, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.Disabled = []string{"hidden"}
+	disabled, err := JSON(ctx, raw, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.JSON != `{}` || disabled.JSON != `{"hidden":true}` || count != 2 {
+		t.Fatalf("JSON() = %s and %s after %d normalizations, want {} and the hidden field after 2", result.JSON, disabled.JSON, count)
+	}
+}
+
+func TestCacheNil(t *testing.T) {
+	var count int
+	opts := countingOptions(nil, &count)
+	for range 2 {
+		if _, err := JSON(context.Background(), `{"a":1}`, opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if count != 2 {
+		t.Fatalf("normalized %d times without a cache, want 2", count)
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	cache := NewCache()
+	var count int
+	opts := countingOptions(cache, &count)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				if _, err := JSON(context.Background(), `{"a":1}`, opts); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if result, ok := cache.get(cacheKey(`{"a":1}`, opts)); !ok || result.JSON != `{"a":1}` {
+		t.Fatalf("cache.get() = %+v, %t", result, ok)
+	}
+}
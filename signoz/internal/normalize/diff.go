@@ -0,0 +1,107 @@
+package normalize
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// diffValueMaxLength - Values longer than this are shortened in the changes.
+const diffValueMaxLength = 60
+
+// Change - Field whose value differs between two JSON values. A field missing
+// on one side has no value there.
+type Change struct {
+	Path   string
+	Old    any
+	New    any
+	HasOld bool
+	HasNew bool
+}
+
+// String - Formats the change as path: old → new.
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s → %s", c.Path, formatDiffValue(c.Old, c.HasOld), formatDiffValue(c.New, c.HasNew))
+}
+
+// Diff - Returns the fields of the normalized JSON that changed from the left
+// side to the right side, sorted by path. Both sides are compared without
+// the default and ignored fields. The paths start with the root, such as the
+// name of the attribute.
+func (c *Comparison) Diff(root string) ([]Change, error) {
+	var left, right any
+	if err := json.Unmarshal([]byte(c.Left.JSON), &left); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(c.Right.JSON), &right); err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	diffValues(root, left, right, true, true, &changes)
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+
+	return changes, nil
+}
+
+// diffValues - Appends the changes from the old value to the new one. Objects
+// and arrays are compared field by field and item by item.
+func diffValues(path string, old, current any, hasOld, hasNew bool, changes *[]Change) {
+	oldMap, oldIsMap := old.(map[string]any)
+	newMap, newIsMap := current.(map[string]any)
+	if oldIsMap && newIsMap {
+		keys := make([]string, 0, len(oldMap)+len(newMap))
+		for key := range oldMap {
+			keys = append(keys, key)
+		}
+		for key := range newMap {
+			if _, ok := oldMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		for _, key := range keys {
+			oldValue, inOld := oldMap[key]
+			newValue, inNew := newMap[key]
+			diffValues(joinKey(path, key), oldValue, newValue, inOld, inNew, changes)
+		}
+		return
+	}
+
+	oldSlice, oldIsSlice := old.([]any)
+	newSlice, newIsSlice := current.([]any)
+	if oldIsSlice && newIsSlice {
+		for i := 0; i < max(len(oldSlice), len(newSlice)); i++ {
+			var oldItem, newItem any
+			if i < len(oldSlice) {
+				oldItem = oldSlice[i]
+			}
+			if i < len(newSlice) {
+				newItem = newSlice[i]
+			}
+			diffValues(joinIndex(path, i), oldItem, newItem, i < len(oldSlice), i < len(newSlice), changes)
+		}
+		return
+	}
+
+	if hasOld != hasNew || !reflect.DeepEqual(old, current) {
+		*changes = append(*changes, Change{Path: path, Old: old, New: current, HasOld: hasOld, HasNew: hasNew})
+	}
+}
+
+// formatDiffValue - Formats the value of a change as JSON, shortened if long.
+func formatDiffValue(value any, ok bool) string {
+	if !ok {
+		return "(none)"
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	if s := []rune(string(b)); len(s) > diffValueMaxLength {
+		return string(s[:diffValueMaxLength]) + "…"
+	}
+
+	return string(b)
+}
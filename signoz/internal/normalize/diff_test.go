@@ -0,0 +1,86 @@
+package normalize
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	cases := []struct {
+		name  string
+		left  string
+		right string
+		opts  Options
+		want  []string
+	}{
+		{name: "equal", left: `{"a":1}`, right: `{ "a": 1 }`},
+		{
+			name:  "changed, added and removed fields",
+			left:  `{"a":1,"b":{"c":"x","d":true}}`,
+			right: `{"a":2,"b":{"c":"x","e":null}}`,
+			want:  []string{"condition.a: 1 → 2", "condition.b.d: true → (none)", "condition.b.e: (none) → null"},
+		},
+		{
+			name:  "array items",
+			left:  `{"a":[1,{"b":1}]}`,
+			right: `{"a":[1,{"b":2},3]}`,
+			want:  []string{"condition.a[1].b: 1 → 2", "condition.a[2]: (none) → 3"},
+		},
+		{
+			name:  "type change",
+			left:  `{"a":{"b":1}}`,
+			right: `{"a":[1]}`,
+			want:  []string{`condition.a: {"b":1} → [1]`},
+		},
+		{
+			name:  "defaults and ignored fields",
+			left:  `{"op":"1","version":"v4"}`,
+			right: `{"op":"2","version":"v5","alertOnAbsent":false}`,
+			opts:  alertOptions(nil, "version"),
+			want:  []string{`condition.op: "1" → "2"`},
+		},
+		{
+			name:  "long values",
+			left:  `{"query":"` + strings.Repeat("a", 70) + `"}`,
+			right: `{"query":"é"}`,
+			want:  []string{`condition.query: "` + strings.Repeat("a", 59) + `… → "é"`},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			comparison, err := Compare(context.Background(), tc.left, tc.right, tc.opts)
+			if err != nil {
+				t.Fatalf("Compare() = %v", err)
+			}
+			changes, err := comparison.Diff("condition")
+			if err != nil {
+				t.Fatalf("Diff() = %v", err)
+			}
+
+			var got []string
+			for _, change := range changes {
+				got = append(got, change.String())
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("Diff() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDiffRoot(t *testing.T) {
+	comparison, err := Compare(context.Background(), `1`, `2`, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, err := comparison.Diff("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].String() != ": 1 → 2" {
+		t.Fatalf("Diff() = %+v", changes)
+	}
+}
@@ -0,0 +1,389 @@
+package normalize
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// SubsystemAlertCondition - Log subsystem for alert conditions.
+	SubsystemAlertCondition = "alert_condition"
+	// SubsystemDashboard - Log subsystem for dashboard content.
+	SubsystemDashboard = "dashboard"
+	// SubsystemPipelineFilter - Log subsystem for log pipeline filters.
+	SubsystemPipelineFilter = "pipeline_filter"
+	// SubsystemReceiver - Log subsystem for Alertmanager receivers.
+	SubsystemReceiver = "receiver"
+
+	// subsystemDefault - Log subsystem used when the options do not set one.
+	subsystemDefault = "json"
+
+	// logLevelEnvPrefix - Prefix of the environment variables setting the log
+	// level of each subsystem, e.g. TF_LOG_PROVIDER_SIGNOZ_NORMALIZE_ALERT_CONDITION.
+	logLevelEnvPrefix = "TF_LOG_PROVIDER_SIGNOZ_NORMALIZE"
+)
+
+// Reason - Why a field was removed during normalization.
+type Reason string
+
+const (
+	// ReasonDefault - The field holds a default value added by SigNoz.
+	ReasonDefault Reason = "default"
+	// ReasonIgnored - The field matches an ignored field pattern.
+	ReasonIgnored Reason = "ignored"
+)
+
+// TransformFunc - Returns the decoded JSON rewritten to a canonical form, such
+// that equivalent shapes compare equal. It must not modify the value.
+type TransformFunc func(data any) any
+
+// DefaultFunc - Returns true if the value of the key is a default added by
+// SigNoz, which does not need to be compared.
+type DefaultFunc func(key string, value any) bool
+
+// Options - Configures the normalization.
+type Options struct {
+	// Subsystem is the log subsystem the normalization reports to.
+	Subsystem string
+	// Defaults identifies the fields holding default values. Optional.
+	Defaults DefaultFunc
+	// Transform rewrites the value before the fields are removed. Optional.
+	Transform TransformFunc
+	// Scope lists the paths of the objects whose fields Defaults applies to,
+	// with * matching any key and the empty path matching the root. Arrays are
+	// traversed transparently. Defaults applies at any depth if Scope is empty,
+	// otherwise fields elsewhere, such as in objects added by newer SigNoz
+	// releases, are kept as they are.
+	Scope []string
+	// Disabled lists the keys whose defaults are kept, i.e. the rules of
+	// Defaults that are not applied, such as hidden when hidden queries are
+	// intentional.
+	Disabled []string
+	// Ignore lists the ignored field patterns. A pattern without dots matches
+	// the key at any depth, while a dotted path is matched from the root, with
+	// * matching any key. Arrays are traversed transparently.
+	Ignore []string
+	// Cache memoizes the normalized JSON strings. Optional.
+	Cache *Cache
+}
+
+// RemovedField - Field removed during normalization.
+type RemovedField struct {
+	Path   string
+	Value  any
+	Reason Reason
+}
+
+// Result - Normalized JSON along with the fields removed from it.
+type Result struct {
+	JSON    string
+	Removed []RemovedField
+}
+
+// Comparison - Outcome of comparing two normalized JSON strings.
+type Comparison struct {
+	Equal bool
+	Left  *Result
+	Right *Result
+}
+
+// Suppressed - Returns the sorted paths of the ignored fields whose values
+// differ between both sides, i.e. the drift hidden by the ignored patterns.
+func (c *Comparison) Suppressed() []string {
+	return c.differing(ReasonIgnored)
+}
+
+// Normalized - Returns the sorted paths of the default fields whose values
+// differ between both sides, such as defaults added by SigNoz to one side only.
+func (c *Comparison) Normalized() []string {
+	return c.differing(ReasonDefault)
+}
+
+// differing - Returns the sorted paths of the fields removed for the reason
+// whose values differ between both sides.
+func (c *Comparison) differing(reason Reason) []string {
+	left := c.Left.removed(reason)
+	right := c.Right.removed(reason)
+
+	paths := make([]string, 0)
+	for path, value := range left {
+		if other, ok := right[path]; !ok || !reflect.DeepEqual(value, other) {
+			paths = append(paths, path)
+		}
+	}
+	for path := range right {
+		if _, ok := left[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+// Paths - Returns the sorted paths of the fields removed for the reason.
+func (r *Result) Paths(reason Reason) []string {
+	paths := make([]string, 0)
+	for path := range r.removed(reason) {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+// removed - Returns the values of the fields removed for the reason by path.
+func (r *Result) removed(reason Reason) map[string]any {
+	values := map[string]any{}
+	for _, field := range r.Removed {
+		if field.Reason == reason {
+			values[field.Path] = field.Value
+		}
+	}
+
+	return values
+}
+
+// JSON - Normalizes the JSON string by removing default and ignored fields and
+// marshaling it with consistent formatting.
+func JSON(ctx context.Context, raw string, opts Options) (*Result, error) {
+	ctx, subsystem := withSubsystem(ctx, opts)
+
+	var key string
+	if opts.Cache != nil {
+		key = cacheKey(raw, opts)
+		if result, ok := opts.Cache.get(key); ok {
+			tflog.SubsystemTrace(ctx, subsystem, "Using cached normalized JSON")
+			return result, nil
+		}
+	}
+
+	var data any
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		tflog.SubsystemDebug(ctx, subsystem, "Failed to unmarshal JSON", map[string]any{"error": err.Error()})
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	result, err := normalizeValue(ctx, subsystem, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	opts.Cache.put(key, result)
+
+	return result, nil
+}
+
+// Value - Normalizes the decoded JSON value, such as an alert condition read
+// from SigNoz, without marshaling it first. The value is not modified.
+func Value(ctx context.Context, data any, opts Options) (*Result, error) {
+	ctx, subsystem := withSubsystem(ctx, opts)
+
+	return normalizeValue(ctx, subsystem, data, opts)
+}
+
+// normalizeValue - Walks the decoded JSON value once and marshals the result.
+func normalizeValue(ctx context.Context, subsystem string, data any, opts Options) (*Result, error) {
+	if opts.Transform != nil {
+		data = opts.Transform(data)
+	}
+
+	n := newNormalizer(opts)
+	b, err := json.Marshal(n.walk("", data, n.paths, n.scope))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range n.removed {
+		tflog.SubsystemTrace(ctx, subsystem, "Removed field", map[string]any{
+			"path":   field.Path,
+			"reason": string(field.Reason),
+		})
+	}
+
+	return &Result{JSON: string(b), Removed: n.removed}, nil
+}
+
+// Compare - Normalizes both JSON strings and compares them.
+func Compare(ctx context.Context, left, right string, opts Options) (*Comparison, error) {
+	leftResult, err := JSON(ctx, left, opts)
+	if err != nil {
+		return nil, err
+	}
+	rightResult, err := JSON(ctx, right, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return compare(ctx, leftResult, rightResult, opts), nil
+}
+
+// CompareValue - Normalizes the JSON string and the decoded JSON value and
+// compares them.
+func CompareValue(ctx context.Context, left string, right any, opts Options) (*Comparison, error) {
+	leftResult, err := JSON(ctx, left, opts)
+	if err != nil {
+		return nil, err
+	}
+	rightResult, err := Value(ctx, right, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return compare(ctx, leftResult, rightResult, opts), nil
+}
+
+// compare - Compares both normalized results.
+func compare(ctx context.Context, left, right *Result, opts Options) *Comparison {
+	ctx, subsystem := withSubsystem(ctx, opts)
+
+	comparison := &Comparison{
+		Equal: left.JSON == right.JSON,
+		Left:  left,
+		Right: right,
+	}
+
+	tflog.SubsystemDebug(ctx, subsystem, "Compared normalized JSON", map[string]any{
+		"left":       left.JSON,
+		"right":      right.JSON,
+		"equal":      comparison.Equal,
+		"suppressed": comparison.Suppressed(),
+	})
+
+	return comparison
+}
+
+// withSubsystem - Returns the context with the log subsystem of the options.
+func withSubsystem(ctx context.Context, opts Options) (context.Context, string) {
+	subsystem := opts.Subsystem
+	if subsystem == "" {
+		subsystem = subsystemDefault
+	}
+
+	return tflog.NewSubsystem(ctx, subsystem, tflog.WithLevelFromEnv(logLevelEnvPrefix, subsystem)), subsystem
+}
+
+// normalizer - Removes fields from decoded JSON and records them, applying
+// the defaults and every ignored field pattern in a single walk.
+type normalizer struct {
+	defaults DefaultFunc
+	// disabled are the keys whose defaults are kept.
+	disabled map[string]bool
+	// keys are the ignored keys matched at any depth.
+	keys map[string]bool
+	// paths are the segments of the ignored paths matched from the root.
+	paths [][]string
+	// scope are the segments of the paths of the objects the defaults apply
+	// to, if scoped.
+	scope   [][]string
+	scoped  bool
+	removed []RemovedField
+}
+
+func newNormalizer(opts Options) *normalizer {
+	n := &normalizer{defaults: opts.Defaults, disabled: map[string]bool{}, keys: map[string]bool{}}
+	for _, key := range opts.Disabled {
+		n.disabled[key] = true
+	}
+	for _, pattern := range opts.Scope {
+		n.scoped = true
+		if pattern == "" {
+			n.scope = append(n.scope, []string{})
+			continue
+		}
+		n.scope = append(n.scope, strings.Split(pattern, "."))
+	}
+	for _, pattern := range opts.Ignore {
+		switch {
+		case pattern == "":
+			continue
+		case strings.Contains(pattern, "."):
+			n.paths = append(n.paths, strings.Split(pattern, "."))
+		default:
+			n.keys[pattern] = true
+		}
+	}
+
+	return n
+}
+
+func (n *normalizer) remove(path string, value any, reason Reason) {
+	n.removed = append(n.removed, RemovedField{Path: path, Value: value, Reason: reason})
+}
+
+// walk - Returns a copy of data without the default and ignored fields. The
+// paths are the remaining segments of the ignored paths that matched so far,
+// and the scope the remaining segments of the scope paths.
+func (n *normalizer) walk(path string, data any, paths, scope [][]string) any {
+	switch v := data.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		inScope := !n.scoped || slices.ContainsFunc(scope, func(segments []string) bool { return len(segments) == 0 })
+		for key, value := range v {
+			keyPath := joinKey(path, key)
+			if n.defaults != nil && inScope && !n.disabled[key] && n.defaults(key, value) {
+				n.remove(keyPath, value, ReasonDefault)
+				continue
+			}
+
+			ignored := n.keys[key]
+			var next [][]string
+			for _, segments := range paths {
+				if segments[0] != "*" && segments[0] != key {
+					continue
+				}
+				if len(segments) == 1 {
+					ignored = true
+					break
+				}
+				next = append(next, segments[1:])
+			}
+			if ignored {
+				n.remove(keyPath, value, ReasonIgnored)
+				continue
+			}
+
+			result[key] = n.walk(keyPath, value, next, nextScope(scope, key))
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = n.walk(joinIndex(path, i), item, paths, scope)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// nextScope - Returns the remaining segments of the scope paths matching the
+// key.
+func nextScope(scope [][]string, key string) [][]string {
+	var next [][]string
+	for _, segments := range scope {
+		if len(segments) > 0 && (segments[0] == "*" || segments[0] == key) {
+			next = append(next, segments[1:])
+		}
+	}
+
+	return next
+}
+
+func joinKey(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func joinIndex(path string, index int) string {
+	return fmt.Sprintf("%s[%d]", path, index)
+}
@@ -0,0 +1,269 @@
+package normalize
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// alertOptions - Options normalizing alert conditions as the alert resource
+// does, with the defaults scoped to the objects of the condition.
+func alertOptions(disabled []string, ignore ...string) Options {
+	return Options{
+		Subsystem: SubsystemAlertCondition,
+		Defaults:  AlertConditionDefaults,
+		Transform: AlertConditionThresholds,
+		Scope:     AlertConditionScope(""),
+		Disabled:  disabled,
+		Ignore:    ignore,
+	}
+}
+
+func TestJSON(t *testing.T) {
+	cases := []struct {
+		name        string
+		raw         string
+		opts        Options
+		want        string
+		wantDefault []string
+		wantIgnored []string
+	}{
+		{
+			name: "formatting",
+			raw:  "{\n  \"b\": [1, 2],\n  \"a\": {\"y\": true, \"x\": null}\n}",
+			want: `{"a":{"x":null,"y":true},"b":[1,2]}`,
+		},
+		{
+			name:        "defaults at any depth",
+			raw:         `{"hidden":true,"a":{"hidden":true,"b":[{"hidden":true,"c":1}]}}`,
+			opts:        Options{Defaults: AlertConditionDefaults},
+			want:        `{"a":{"b":[{"c":1}]}}`,
+			wantDefault: []string{"a.b[0].hidden", "a.hidden", "hidden"},
+		},
+		{
+			name: "alert condition defaults",
+			raw: `{"alertOnAbsent":false,"absentFor":0,"compositeQuery":{"builderQueries":{"A":{"groupBy":[],` +
+				`"reduceTo":"","timeAggregation":"","spaceAggregation":"sum","IsAnomaly":false,"QueriesUsedInFormula":null}}}}`,
+			opts: alertOptions(nil),
+			want: `{"compositeQuery":{"builderQueries":{"A":{"spaceAggregation":"sum"}}}}`,
+			wantDefault: []string{
+				"absentFor", "alertOnAbsent", "compositeQuery.builderQueries.A.IsAnomaly",
+				"compositeQuery.builderQueries.A.QueriesUsedInFormula", "compositeQuery.builderQueries.A.groupBy",
+				"compositeQuery.builderQueries.A.reduceTo", "compositeQuery.builderQueries.A.timeAggregation",
+			},
+		},
+		{
+			name: "non-default values are kept",
+			raw:  `{"alertOnAbsent":true,"absentFor":5,"compositeQuery":{"builderQueries":{"A":{"groupBy":["a"],"hidden":false}}}}`,
+			opts: alertOptions(nil),
+			want: `{"absentFor":5,"alertOnAbsent":true,"compositeQuery":{"builderQueries":{"A":{"groupBy":["a"],"hidden":false}}}}`,
+		},
+		{
+			name: "defaults out of scope are kept",
+			raw: `{"hidden":true,"compositeQuery":{"hidden":true,"queries":[{"type":"builder_query",` +
+				`"spec":{"hidden":true,"aggregations":[{"reduceTo":""}],"having":{"hidden":true}}}]}}`,
+			opts:        alertOptions(nil),
+			want:        `{"compositeQuery":{"hidden":true,"queries":[{"spec":{"aggregations":[{}],"having":{"hidden":true}},"type":"builder_query"}]}}`,
+			wantDefault: []string{"compositeQuery.queries[0].spec.aggregations[0].reduceTo", "compositeQuery.queries[0].spec.hidden", "hidden"},
+		},
+		{
+			name:        "scope under a prefix",
+			raw:         `{"hidden":true,"condition":{"hidden":true,"compositeQuery":{"builderQueries":{"A":{"hidden":true}}}}}`,
+			opts:        Options{Defaults: AlertConditionDefaults, Scope: AlertConditionScope("condition")},
+			want:        `{"condition":{"compositeQuery":{"builderQueries":{"A":{}}}},"hidden":true}`,
+			wantDefault: []string{"condition.compositeQuery.builderQueries.A.hidden", "condition.hidden"},
+		},
+		{
+			name:        "disabled rules",
+			raw:         `{"alertOnAbsent":false,"compositeQuery":{"builderQueries":{"A":{"hidden":true,"reduceTo":""}}}}`,
+			opts:        alertOptions([]string{"hidden", "alertOnAbsent"}),
+			want:        `{"alertOnAbsent":false,"compositeQuery":{"builderQueries":{"A":{"hidden":true}}}}`,
+			wantDefault: []string{"compositeQuery.builderQueries.A.reduceTo"},
+		},
+		{
+			name:        "ignored keys and paths",
+			raw:         `{"id":1,"a":{"id":2,"b":[{"c":1,"d":2}],"e":3},"f":{"b":[{"c":1}]}}`,
+			opts:        Options{Ignore: []string{"id", "a.b.c", "*.e", ""}},
+			want:        `{"a":{"b":[{"d":2}]},"f":{"b":[{"c":1}]}}`,
+			wantIgnored: []string{"a.b[0].c", "a.e", "a.id", "id"},
+		},
+		{
+			name:        "defaults take precedence over ignored fields",
+			raw:         `{"hidden":true,"version":"v4"}`,
+			opts:        Options{Defaults: AlertConditionDefaults, Ignore: []string{"hidden", "version"}},
+			want:        `{}`,
+			wantDefault: []string{"hidden"},
+			wantIgnored: []string{"version"},
+		},
+		{
+			name: "thresholds derived from the target",
+			raw: `{"op":"1","target":10,"matchType":"1","thresholds":{"kind":"basic","spec":[` +
+				`{"name":"critical","op":"1","target":10,"matchType":"1","targetUnit":""}]}}`,
+			opts: alertOptions(nil),
+			want: `{"matchType":"1","op":"1","target":10}`,
+		},
+		{
+			name: "thresholds with a recovery target",
+			raw:  `{"op":"1","target":10,"thresholds":{"kind":"basic","spec":[{"op":"1","target":10,"recoveryTarget":5}]}}`,
+			opts: alertOptions(nil),
+			want: `{"op":"1","target":10,"thresholds":{"kind":"basic","spec":[{"op":"1","recoveryTarget":5,"target":10}]}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := JSON(context.Background(), tc.raw, tc.opts)
+			if err != nil {
+				t.Fatalf("JSON() = %v", err)
+			}
+			if result.JSON != tc.want {
+				t.Errorf("JSON() = %s, want %s", result.JSON, tc.want)
+			}
+			if got := result.Paths(ReasonDefault); !reflect.DeepEqual(got, nonNil(tc.wantDefault)) {
+				t.Errorf("default fields = %v, want %v", got, tc.wantDefault)
+			}
+			if got := result.Paths(ReasonIgnored); !reflect.DeepEqual(got, nonNil(tc.wantIgnored)) {
+				t.Errorf("ignored fields = %v, want %v", got, tc.wantIgnored)
+			}
+		})
+	}
+}
+
+func TestJSONInvalid(t *testing.T) {
+	for _, raw := range []string{"", "{", `{"a":}`} {
+		if _, err := JSON(context.Background(), raw, Options{}); err == nil {
+			t.Errorf("JSON(%q) succeeded, want an error", raw)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	raw := `{"alertOnAbsent":false,"op":"1","compositeQuery":{"builderQueries":{"A":{"hidden":true,"legend":""}}}}`
+	var data any
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Value(context.Background(), data, alertOptions(nil, "legend"))
+	if err != nil {
+		t.Fatalf("Value() = %v", err)
+	}
+	if want := `{"compositeQuery":{"builderQueries":{"A":{}}},"op":"1"}`; result.JSON != want {
+		t.Errorf("Value() = %s, want %s", result.JSON, want)
+	}
+
+	// The value is not modified.
+	b, _ := json.Marshal(data)
+	var original any
+	_ = json.Unmarshal([]byte(raw), &original)
+	if !reflect.DeepEqual(data, original) {
+		t.Errorf("Value() modified the value to %s", b)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		name           string
+		left           string
+		right          string
+		opts           Options
+		wantEqual      bool
+		wantSuppressed []string
+		wantNormalized []string
+	}{
+		{
+			name:      "formatting only",
+			left:      `{"a": 1, "b": [true]}`,
+			right:     "{\"b\":[true],\n\"a\":1}",
+			wantEqual: true,
+		},
+		{
+			name:      "different values",
+			left:      `{"a":1}`,
+			right:     `{"a":2}`,
+			wantEqual: false,
+		},
+		{
+			name:           "default added by SigNoz",
+			left:           `{"op":"1","compositeQuery":{"builderQueries":{"A":{"queryName":"A"}}}}`,
+			right:          `{"op":"1","absentFor":0,"compositeQuery":{"builderQueries":{"A":{"queryName":"A","hidden":true,"groupBy":[]}}}}`,
+			opts:           alertOptions(nil),
+			wantEqual:      true,
+			wantNormalized: []string{"absentFor", "compositeQuery.builderQueries.A.groupBy", "compositeQuery.builderQueries.A.hidden"},
+		},
+		{
+			name:      "disabled default rule",
+			left:      `{"op":"1","compositeQuery":{"builderQueries":{"A":{"queryName":"A"}}}}`,
+			right:     `{"op":"1","compositeQuery":{"builderQueries":{"A":{"queryName":"A","hidden":true}}}}`,
+			opts:      alertOptions([]string{"hidden"}),
+			wantEqual: false,
+		},
+		{
+			name:           "ignored drift",
+			left:           `{"op":"1","version":"v4","evalWindow":"5m"}`,
+			right:          `{"op":"1","version":"v5","evalWindow":"5m"}`,
+			opts:           alertOptions(nil, "version", "evalWindow"),
+			wantEqual:      true,
+			wantSuppressed: []string{"version"},
+		},
+		{
+			name:           "ignored field on one side",
+			left:           `{"op":"1"}`,
+			right:          `{"op":"1","compositeQuery":{"unit":"ms"}}`,
+			opts:           Options{Ignore: []string{"compositeQuery.unit"}},
+			wantEqual:      false,
+			wantSuppressed: []string{"compositeQuery.unit"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			comparison, err := Compare(context.Background(), tc.left, tc.right, tc.opts)
+			if err != nil {
+				t.Fatalf("Compare() = %v", err)
+			}
+			if comparison.Equal != tc.wantEqual {
+				t.Errorf("Equal = %t, want %t: %s and %s", comparison.Equal, tc.wantEqual, comparison.Left.JSON, comparison.Right.JSON)
+			}
+			if got := comparison.Suppressed(); !reflect.DeepEqual(got, nonNil(tc.wantSuppressed)) {
+				t.Errorf("Suppressed() = %v, want %v", got, tc.wantSuppressed)
+			}
+			if got := comparison.Normalized(); !reflect.DeepEqual(got, nonNil(tc.wantNormalized)) {
+				t.Errorf("Normalized() = %v, want %v", got, tc.wantNormalized)
+			}
+		})
+	}
+}
+
+func TestCompareValue(t *testing.T) {
+	read := map[string]any{
+		"op":            "1",
+		"alertOnAbsent": false,
+		"absentFor":     float64(0),
+		"compositeQuery": map[string]any{
+			"builderQueries": map[string]any{"A": map[string]any{"hidden": true, "stepInterval": float64(60)}},
+		},
+	}
+
+	comparison, err := CompareValue(context.Background(), `{"op":"1","compositeQuery":{"builderQueries":{"A":{"stepInterval":60}}}}`,
+		read, alertOptions(nil))
+	if err != nil {
+		t.Fatalf("CompareValue() = %v", err)
+	}
+	if !comparison.Equal {
+		t.Errorf("CompareValue() differs: %s and %s", comparison.Left.JSON, comparison.Right.JSON)
+	}
+
+	if _, err := CompareValue(context.Background(), `{`, read, Options{}); err == nil {
+		t.Errorf("CompareValue() with invalid JSON succeeded")
+	}
+}
+
+func nonNil(paths []string) []string {
+	if paths == nil {
+		return []string{}
+	}
+
+	return paths
+}
@@ -199,7 +199,7 @@ func (d *alertDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	data.Labels, diags = alert.LabelsToTerraform()
+	data.Labels, diags = alert.LabelsToTerraform(d.client.ManagedAlertLabels(), false)
 	resp.Diagnostics.Append(diags...)
 
 	data.PreferredChannels, diags = alert.PreferredChannelsToTerraform()
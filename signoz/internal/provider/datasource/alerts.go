@@ -0,0 +1,216 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+)
+
+// invalidResourceName - Matches the characters not allowed in resource names.
+//
+//nolint:gochecknoglobals
+var invalidResourceName = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &alertsDataSource{}
+	_ datasource.DataSourceWithConfigure = &alertsDataSource{}
+)
+
+// NewAlertsDataSource is a helper function to simplify the provider implementation.
+func NewAlertsDataSource() datasource.DataSource {
+	return &alertsDataSource{}
+}
+
+// alertsDataSource is the data source implementation.
+type alertsDataSource struct {
+	client *client.Client
+}
+
+// alertsModel maps alerts schema data.
+type alertsModel struct {
+	Alerts       []alertSummaryModel `tfsdk:"alerts"`
+	ImportBlocks types.String        `tfsdk:"import_blocks"`
+}
+
+// alertSummaryModel maps an existing alert.
+type alertSummaryModel struct {
+	ID        types.String `tfsdk:"id"`
+	Alert     types.String `tfsdk:"alert"`
+	AlertType types.String `tfsdk:"alert_type"`
+	Disabled  types.Bool   `tfsdk:"disabled"`
+	Labels    types.Map    `tfsdk:"labels"`
+	Name      types.String `tfsdk:"name"`
+	RuleType  types.String `tfsdk:"rule_type"`
+	Severity  types.String `tfsdk:"severity"`
+	State     types.String `tfsdk:"state"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *alertsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform.
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected data source configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			SigNozAlerts,
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *alertsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = SigNozAlerts
+}
+
+// Schema defines the schema for the data source.
+func (d *alertsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every alert rule of SigNoz, along with import blocks to adopt them all at once with " +
+			"terraform plan -generate-config-out.",
+		Attributes: map[string]schema.Attribute{
+			attr.Alerts: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Existing alerts, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the alert, to import it with.",
+						},
+						attr.Alert: schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the alert.",
+						},
+						attr.AlertType: schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the alert.",
+						},
+						attr.Disabled: schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the alert is disabled.",
+						},
+						attr.Labels: schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Labels of the alert.",
+						},
+						attr.Name: schema.StringAttribute{
+							Computed:    true,
+							Description: "Resource name derived from the name of the alert, unique among the alerts.",
+						},
+						attr.RuleType: schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the alert rule.",
+						},
+						attr.Severity: schema.StringAttribute{
+							Computed:    true,
+							Description: "Severity of the alert.",
+						},
+						attr.State: schema.StringAttribute{
+							Computed:    true,
+							Description: "State of the alert.",
+						},
+					},
+				},
+			},
+			attr.ImportBlocks: schema.StringAttribute{
+				Computed: true,
+				Description: "Import blocks of every alert, addressed as signoz_alert.<name>. Write them to a file and run " +
+					"terraform plan -generate-config-out=alerts.tf to generate their configuration.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *alertsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data alertsModel
+	var diags diag.Diagnostics
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alerts, err := d.client.ListAlerts(ctx)
+	if err != nil {
+		addErr(&resp.Diagnostics, fmt.Errorf("unable to list SigNoz alerts: %s", err.Error()), SigNozAlerts)
+		return
+	}
+
+	sort.SliceStable(alerts, func(i, j int) bool {
+		if alerts[i].Alert != alerts[j].Alert {
+			return alerts[i].Alert < alerts[j].Alert
+		}
+		return alerts[i].ID < alerts[j].ID
+	})
+
+	// Set state values from retrieved data.
+	names := map[string]bool{}
+	var blocks strings.Builder
+	data.Alerts = make([]alertSummaryModel, 0, len(alerts))
+	for _, alert := range alerts {
+		name := resourceName(alert.Alert, names)
+
+		summary := alertSummaryModel{
+			ID:        types.StringValue(alert.ID),
+			Alert:     types.StringValue(alert.Alert),
+			AlertType: types.StringValue(alert.AlertType),
+			Disabled:  types.BoolValue(alert.Disabled),
+			Name:      types.StringValue(name),
+			RuleType:  types.StringValue(alert.RuleType),
+			Severity:  types.StringValue(alert.Labels[attr.Severity]),
+			State:     types.StringValue(alert.State),
+		}
+		summary.Labels, diags = alert.LabelsToTerraform(d.client.ManagedAlertLabels(), false)
+		resp.Diagnostics.Append(diags...)
+		data.Alerts = append(data.Alerts, summary)
+
+		fmt.Fprintf(&blocks, "import {\n  to = signoz_alert.%s\n  id = %q\n}\n\n", name, alert.ID)
+	}
+	data.ImportBlocks = types.StringValue(strings.TrimSuffix(blocks.String(), "\n"))
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resourceName - Returns a resource name derived from the alert name, made
+// unique among the names already taken by suffixing a number.
+func resourceName(alertName string, taken map[string]bool) string {
+	name := strings.Trim(invalidResourceName.ReplaceAllString(strings.ToLower(alertName), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "alert_" + name
+		name = strings.TrimSuffix(name, "_")
+	}
+
+	unique := name
+	for i := 2; taken[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	taken[unique] = true
+
+	return unique
+}
@@ -1,8 +1,15 @@
 package datasource
 
 const (
-	SigNozAlert     = "signoz_alert"
-	SigNozDashboard = "signoz_dashboard"
+	SigNozAlert               = "signoz_alert"
+	SigNozAlerts              = "signoz_alerts"
+	SigNozDashboard           = "signoz_dashboard"
+	SigNozDashboardTemplate   = "signoz_dashboard_template"
+	SigNozLogsPipelineHistory = "signoz_logs_pipeline_history"
+	SigNozLogsPipelines       = "signoz_logs_pipelines"
+	SigNozQueryRange          = "signoz_query_range"
+	SigNozRoles               = "signoz_roles"
+	SigNozUsers               = "signoz_users"
 
 	operationRead = "read"
 )
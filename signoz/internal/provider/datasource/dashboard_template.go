@@ -0,0 +1,210 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &dashboardTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure        = &dashboardTemplateDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &dashboardTemplateDataSource{}
+)
+
+// NewDashboardTemplateDataSource is a helper function to simplify the provider implementation.
+func NewDashboardTemplateDataSource() datasource.DataSource {
+	return &dashboardTemplateDataSource{}
+}
+
+// dashboardTemplateDataSource is the data source implementation.
+type dashboardTemplateDataSource struct {
+	client *client.Client
+}
+
+// dashboardTemplateModel maps dashboard template schema data.
+type dashboardTemplateModel struct {
+	BaseURL     types.String `tfsdk:"base_url"`
+	Description types.String `tfsdk:"description"`
+	JSON        types.String `tfsdk:"json"`
+	Layout      types.String `tfsdk:"layout"`
+	Name        types.String `tfsdk:"name"`
+	PanelMap    types.String `tfsdk:"panel_map"`
+	Tags        types.List   `tfsdk:"tags"`
+	Title       types.String `tfsdk:"title"`
+	URL         types.String `tfsdk:"url"`
+	Variables   types.String `tfsdk:"variables"`
+	Widgets     types.String `tfsdk:"widgets"`
+}
+
+// Metadata returns the data source type name.
+func (d *dashboardTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = SigNozDashboardTemplate
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dashboardTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform.
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected data source configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			SigNozDashboardTemplate,
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *dashboardTemplateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a dashboard template, such as the ClickHouse, Kafka or JVM dashboards of the SigNoz dashboards " +
+			"repository (https://github.com/SigNoz/dashboards), so that it can be deployed with the signoz_dashboard resource.",
+		Attributes: map[string]schema.Attribute{
+			attr.BaseURL: schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Base URL the name of the template is resolved against, such as the raw URL of a fork "+
+					"or a mirror of the repository. If not set, it defaults to %s.", client.DefaultDashboardTemplatesURL),
+			},
+			attr.Description: schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the dashboard.",
+			},
+			attr.JSON: schema.StringAttribute{
+				Computed:    true,
+				Description: "Template as downloaded.",
+			},
+			attr.Layout: schema.StringAttribute{
+				Computed:    true,
+				Description: "Layout of the dashboard.",
+			},
+			attr.Name: schema.StringAttribute{
+				Optional: true,
+				Description: "Path of the template in the repository, with or without the .json extension, " +
+					"such as clickhouse/clickhouse-overview. Conflicts with url.",
+			},
+			attr.PanelMap: schema.StringAttribute{
+				Computed:    true,
+				Description: "Panel map of the dashboard.",
+			},
+			attr.Tags: schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Tags of the dashboard.",
+			},
+			attr.Title: schema.StringAttribute{
+				Computed:    true,
+				Description: "Title of the dashboard.",
+			},
+			attr.URL: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "URL of the template. Conflicts with name, and set to the URL the name resolves to otherwise.",
+			},
+			attr.Variables: schema.StringAttribute{
+				Computed:    true,
+				Description: "Variables for the dashboard.",
+			},
+			attr.Widgets: schema.StringAttribute{
+				Computed:    true,
+				Description: "Widgets for the dashboard.",
+			},
+		},
+	}
+}
+
+// ConfigValidators returns the validators of the data source configuration.
+func (d *dashboardTemplateDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot(attr.Name), path.MatchRoot(attr.URL)),
+		datasourcevalidator.Conflicting(path.MatchRoot(attr.URL), path.MatchRoot(attr.BaseURL)),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dashboardTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dashboardTemplateModel
+	var diags diag.Diagnostics
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templateURL := data.URL.ValueString()
+	if templateURL == "" {
+		baseURL := data.BaseURL.ValueString()
+		if baseURL == "" {
+			baseURL = client.DefaultDashboardTemplatesURL
+		}
+
+		var err error
+		templateURL, err = client.DashboardTemplateURL(baseURL, data.Name.ValueString())
+		if err != nil {
+			addErr(&resp.Diagnostics, err, SigNozDashboardTemplate)
+			return
+		}
+	}
+
+	dashboard, raw, err := d.client.GetDashboardTemplate(ctx, templateURL)
+	if err != nil {
+		addErr(&resp.Diagnostics, fmt.Errorf("unable to download dashboard template: %s", err.Error()), SigNozDashboardTemplate)
+		return
+	}
+
+	// Set state values from retrieved data.
+	data.URL = types.StringValue(templateURL)
+	data.JSON = types.StringValue(raw)
+	data.Description = types.StringValue(dashboard.Description)
+	data.Title = types.StringValue(dashboard.Title)
+
+	data.PanelMap, err = dashboard.PanelMapToTerraform()
+	if err != nil {
+		addErr(&resp.Diagnostics, err, SigNozDashboardTemplate)
+		return
+	}
+
+	data.Variables, err = dashboard.VariablesToTerraform()
+	if err != nil {
+		addErr(&resp.Diagnostics, err, SigNozDashboardTemplate)
+		return
+	}
+
+	data.Layout, err = dashboard.LayoutToTerraform()
+	if err != nil {
+		addErr(&resp.Diagnostics, err, SigNozDashboardTemplate)
+		return
+	}
+
+	data.Widgets, err = dashboard.WidgetsToTerraform()
+	if err != nil {
+		addErr(&resp.Diagnostics, err, SigNozDashboardTemplate)
+		return
+	}
+
+	data.Tags, diags = dashboard.TagsToTerraform()
+	resp.Diagnostics.Append(diags...)
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
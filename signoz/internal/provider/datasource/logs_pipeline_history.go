@@ -0,0 +1,161 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &logsPipelineHistoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &logsPipelineHistoryDataSource{}
+)
+
+// NewLogsPipelineHistoryDataSource is a helper function to simplify the provider implementation.
+func NewLogsPipelineHistoryDataSource() datasource.DataSource {
+	return &logsPipelineHistoryDataSource{}
+}
+
+// logsPipelineHistoryDataSource is the data source implementation.
+type logsPipelineHistoryDataSource struct {
+	client *client.Client
+}
+
+// logsPipelineHistoryModel maps logs pipeline history schema data.
+type logsPipelineHistoryModel struct {
+	Versions []logsPipelineVersionModel `tfsdk:"versions"`
+}
+
+// logsPipelineVersionModel maps a saved version of the pipelines.
+type logsPipelineVersionModel struct {
+	CreatedAt     types.String `tfsdk:"created_at"`
+	CreatedBy     types.String `tfsdk:"created_by"`
+	CreatedByName types.String `tfsdk:"created_by_name"`
+	DeployResult  types.String `tfsdk:"deploy_result"`
+	DeployStatus  types.String `tfsdk:"deploy_status"`
+	Version       types.Int64  `tfsdk:"version"`
+}
+
+// Metadata returns the data source type name.
+func (d *logsPipelineHistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = SigNozLogsPipelineHistory
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *logsPipelineHistoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform.
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected data source configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			SigNozLogsPipelineHistory,
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *logsPipelineHistoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the deployment history of the log pipelines: who saved each version, when, and whether " +
+			"it was deployed to the collectors.",
+		Attributes: map[string]schema.Attribute{
+			attr.Versions: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Saved versions of the pipelines, latest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.CreatedAt: schema.StringAttribute{
+							Computed:    true,
+							Description: "When the version was saved.",
+						},
+						attr.CreatedBy: schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the user who saved the version.",
+						},
+						attr.CreatedByName: schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the user who saved the version.",
+						},
+						attr.DeployResult: schema.StringAttribute{
+							Computed:    true,
+							Description: "Message of the deployment, such as the error of a failed deployment.",
+						},
+						attr.DeployStatus: schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the deployment to the collectors, such as DEPLOYED, IN_PROGRESS or FAILED.",
+						},
+						attr.Version: schema.Int64Attribute{
+							Computed:    true,
+							Description: "Version of the pipelines, incremented on every save.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *logsPipelineHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data logsPipelineHistoryModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pipelines, err := d.client.GetPipelines(ctx)
+	if err != nil {
+		addErr(&resp.Diagnostics, fmt.Errorf("unable to read SigNoz log pipelines: %s", err.Error()), SigNozLogsPipelineHistory)
+		return
+	}
+
+	// The history usually includes the latest version, which is only added
+	// when it is missing.
+	versions := map[int]model.PipelinesVersion{}
+	for _, version := range pipelines.History {
+		versions[version.Version] = version
+	}
+	if _, ok := versions[pipelines.Version]; !ok && pipelines.Version > 0 {
+		versions[pipelines.Version] = pipelines.PipelinesVersion
+	}
+
+	// Set state values from retrieved data.
+	data.Versions = make([]logsPipelineVersionModel, 0, len(versions))
+	for _, version := range versions {
+		data.Versions = append(data.Versions, logsPipelineVersionModel{
+			CreatedAt:     types.StringValue(version.CreatedAt),
+			CreatedBy:     types.StringValue(version.CreatedBy),
+			CreatedByName: types.StringValue(version.CreatedByName),
+			DeployResult:  types.StringValue(version.DeployResult),
+			DeployStatus:  types.StringValue(version.DeployStatus),
+			Version:       types.Int64Value(int64(version.Version)),
+		})
+	}
+	sort.Slice(data.Versions, func(i, j int) bool {
+		return data.Versions[i].Version.ValueInt64() > data.Versions[j].Version.ValueInt64()
+	})
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
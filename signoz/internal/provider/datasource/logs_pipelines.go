@@ -0,0 +1,173 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &logsPipelinesDataSource{}
+	_ datasource.DataSourceWithConfigure = &logsPipelinesDataSource{}
+)
+
+// NewLogsPipelinesDataSource is a helper function to simplify the provider implementation.
+func NewLogsPipelinesDataSource() datasource.DataSource {
+	return &logsPipelinesDataSource{}
+}
+
+// logsPipelinesDataSource is the data source implementation.
+type logsPipelinesDataSource struct {
+	client *client.Client
+}
+
+// logsPipelinesModel maps logs pipelines schema data.
+type logsPipelinesModel struct {
+	Pipelines []logsPipelineSummaryModel `tfsdk:"pipelines"`
+	Version   types.Int64                `tfsdk:"version"`
+}
+
+// logsPipelineSummaryModel maps a deployed pipeline.
+type logsPipelineSummaryModel struct {
+	Alias       types.String `tfsdk:"alias"`
+	Description types.String `tfsdk:"description"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Filter      types.String `tfsdk:"filter"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	OrderID     types.Int64  `tfsdk:"order_id"`
+}
+
+// Metadata returns the data source type name.
+func (d *logsPipelinesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = SigNozLogsPipelines
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *logsPipelinesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform.
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected data source configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			SigNozLogsPipelines,
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *logsPipelinesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the log pipelines currently deployed in SigNoz, in the order they are applied.",
+		Attributes: map[string]schema.Attribute{
+			attr.Pipelines: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Deployed pipelines, sorted by order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.Alias: schema.StringAttribute{
+							Computed:    true,
+							Description: "Alias of the pipeline.",
+						},
+						attr.Description: schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the pipeline.",
+						},
+						attr.Enabled: schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the pipeline is enabled.",
+						},
+						attr.Filter: schema.StringAttribute{
+							Computed:    true,
+							Description: "Filter selecting the logs processed by the pipeline, as JSON.",
+						},
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the pipeline.",
+						},
+						attr.Name: schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the pipeline.",
+						},
+						attr.OrderID: schema.Int64Attribute{
+							Computed:    true,
+							Description: "Position of the pipeline, starting at 1.",
+						},
+					},
+				},
+			},
+			attr.Version: schema.Int64Attribute{
+				Computed:    true,
+				Description: "Version of the deployed pipelines, incremented on every save.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *logsPipelinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data logsPipelinesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pipelines, err := d.client.GetPipelines(ctx)
+	if err != nil {
+		addErr(&resp.Diagnostics, fmt.Errorf("unable to read SigNoz log pipelines: %s", err.Error()), SigNozLogsPipelines)
+		return
+	}
+
+	sort.SliceStable(pipelines.Pipelines, func(i, j int) bool {
+		return pipelines.Pipelines[i].OrderID < pipelines.Pipelines[j].OrderID
+	})
+
+	// Set state values from retrieved data.
+	data.Version = types.Int64Value(int64(pipelines.Version))
+	data.Pipelines = make([]logsPipelineSummaryModel, 0, len(pipelines.Pipelines))
+	for _, pipeline := range pipelines.Pipelines {
+		filter := types.StringNull()
+		if pipeline.Filter != nil {
+			value, err := structure.FlattenJsonToString(pipeline.Filter)
+			if err != nil {
+				addErr(&resp.Diagnostics, err, SigNozLogsPipelines)
+				return
+			}
+			filter = types.StringValue(value)
+		}
+
+		data.Pipelines = append(data.Pipelines, logsPipelineSummaryModel{
+			Alias:       types.StringValue(pipeline.Alias),
+			Description: types.StringValue(pipeline.Description),
+			Enabled:     types.BoolValue(pipeline.Enabled),
+			Filter:      filter,
+			ID:          types.StringValue(pipeline.ID),
+			Name:        types.StringValue(pipeline.Name),
+			OrderID:     types.Int64Value(int64(pipeline.OrderID)),
+		})
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
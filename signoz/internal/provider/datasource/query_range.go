@@ -0,0 +1,174 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+)
+
+const (
+	// defaultQueryWindow - Default time window the query runs over, ending now.
+	defaultQueryWindow = "15m"
+	// defaultQueryStep - Default step of the query in seconds.
+	defaultQueryStep = 60
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &queryRangeDataSource{}
+	_ datasource.DataSourceWithConfigure = &queryRangeDataSource{}
+)
+
+// NewQueryRangeDataSource is a helper function to simplify the provider implementation.
+func NewQueryRangeDataSource() datasource.DataSource {
+	return &queryRangeDataSource{}
+}
+
+// queryRangeDataSource is the data source implementation.
+type queryRangeDataSource struct {
+	client *client.Client
+}
+
+// queryRangeModel maps query range schema data.
+type queryRangeModel struct {
+	CompositeQuery types.String `tfsdk:"composite_query"`
+	HasData        types.Bool   `tfsdk:"has_data"`
+	SeriesCount    types.Int64  `tfsdk:"series_count"`
+	SeriesCounts   types.Map    `tfsdk:"series_counts"`
+	Step           types.Int64  `tfsdk:"step"`
+	Window         types.String `tfsdk:"window"`
+}
+
+// Metadata returns the data source type name.
+func (d *queryRangeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = SigNozQueryRange
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *queryRangeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform.
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected data source configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			SigNozQueryRange,
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *queryRangeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a composite query, such as the one of an alert condition, over a short time window ending now " +
+			"and returns the number of series it returns. Use it in preconditions to check that an alert query " +
+			"returns data in the environment before creating the alert.",
+		Attributes: map[string]schema.Attribute{
+			attr.CompositeQuery: schema.StringAttribute{
+				Required: true,
+				Description: "Composite query to run, as JSON. For an alert, it is the compositeQuery of the condition, " +
+					"e.g. jsonencode(jsondecode(signoz_alert.example.condition).compositeQuery).",
+			},
+			attr.HasData: schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether any query returned at least one series or row.",
+			},
+			attr.SeriesCount: schema.Int64Attribute{
+				Computed:    true,
+				Description: "Total number of series, or rows for list queries, returned by the queries.",
+			},
+			attr.SeriesCounts: schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "Number of series, or rows for list queries, returned by each query, by query name.",
+			},
+			attr.Step: schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Step of the query in seconds. If not set, it defaults to %d.", defaultQueryStep),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			attr.Window: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Time window the query runs over, ending now, such as 1h. If not set, it defaults to %s.", defaultQueryWindow),
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *queryRangeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data queryRangeModel
+	var diags diag.Diagnostics
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compositeQuery, err := structure.ExpandJsonFromString(data.CompositeQuery.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, fmt.Errorf("invalid %s: %w", attr.CompositeQuery, err), SigNozQueryRange)
+		return
+	}
+
+	window := data.Window.ValueString()
+	if window == "" {
+		window = defaultQueryWindow
+	}
+	duration, err := time.ParseDuration(window)
+	if err != nil || duration <= 0 {
+		addErr(&resp.Diagnostics, fmt.Errorf("invalid %s %q: should be a positive duration such as 15m", attr.Window, window), SigNozQueryRange)
+		return
+	}
+
+	step := data.Step.ValueInt64()
+	if step == 0 {
+		step = defaultQueryStep
+	}
+
+	end := time.Now()
+	results, err := d.client.QueryRange(ctx, compositeQuery, end.Add(-duration), end, step)
+	if err != nil {
+		addErr(&resp.Diagnostics, fmt.Errorf("unable to run SigNoz query: %s", err.Error()), SigNozQueryRange)
+		return
+	}
+
+	// Set state values from retrieved data.
+	total := int64(0)
+	counts := map[string]int64{}
+	for _, result := range results {
+		count := int64(len(result.Series) + len(result.List))
+		counts[result.QueryName] += count
+		total += count
+	}
+	data.SeriesCount = types.Int64Value(total)
+	data.HasData = types.BoolValue(total > 0)
+	data.SeriesCounts, diags = types.MapValueFrom(ctx, types.Int64Type, counts)
+	resp.Diagnostics.Append(diags...)
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,102 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &rolesDataSource{}
+)
+
+// NewRolesDataSource is a helper function to simplify the provider implementation.
+func NewRolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+// rolesDataSource is the data source implementation. The roles of SigNoz are
+// built in, so no request is made.
+type rolesDataSource struct{}
+
+// rolesModel maps roles schema data.
+type rolesModel struct {
+	Roles []roleModel `tfsdk:"roles"`
+}
+
+// roleModel maps a role of SigNoz.
+type roleModel struct {
+	Description types.String   `tfsdk:"description"`
+	Name        types.String   `tfsdk:"name"`
+	Permissions []types.String `tfsdk:"permissions"`
+}
+
+// Metadata returns the data source type name.
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = SigNozRoles
+}
+
+// Schema defines the schema for the data source.
+func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the roles users can hold in SigNoz along with their permissions, e.g. to validate the roles " +
+			"of a roster at plan time before assigning them with signoz_user_role. SigNoz has built-in roles only, " +
+			"which are not listed by its API.",
+		Attributes: map[string]schema.Attribute{
+			attr.Roles: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Roles, from the most to the least privileged.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.Description: schema.StringAttribute{
+							Computed:    true,
+							Description: "What users with the role can do.",
+						},
+						attr.Name: schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the role, as used by signoz_user_role.",
+						},
+						attr.Permissions: schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Permissions of the role. Possible values are: " + model.UserPermissionRead + ", " +
+								model.UserPermissionWrite + ", and " + model.UserPermissionManage + ".",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read sets the Terraform state with the built-in roles.
+func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data rolesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Roles = make([]roleModel, 0, len(model.UserRoles))
+	for _, role := range model.UserRoles {
+		permissions := make([]types.String, 0, len(model.UserRolePermissions[role]))
+		for _, permission := range model.UserRolePermissions[role] {
+			permissions = append(permissions, types.StringValue(permission))
+		}
+		data.Roles = append(data.Roles, roleModel{
+			Description: types.StringValue(model.UserRoleDescriptions[role]),
+			Name:        types.StringValue(role),
+			Permissions: permissions,
+		})
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
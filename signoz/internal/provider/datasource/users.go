@@ -0,0 +1,145 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client *client.Client
+}
+
+// usersModel maps users schema data.
+type usersModel struct {
+	Users []userModel `tfsdk:"users"`
+}
+
+// userModel maps a user of the organization.
+type userModel struct {
+	CreatedAt types.String `tfsdk:"created_at"`
+	Email     types.String `tfsdk:"email"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Role      types.String `tfsdk:"role"`
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = SigNozUsers
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform.
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected data source configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			SigNozUsers,
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the users of the SigNoz organization, for access reviews or to drive signoz_user_role " +
+			"resources from a roster.",
+		Attributes: map[string]schema.Attribute{
+			attr.Users: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Users of the organization, sorted by email.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.CreatedAt: schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation time of the user, as an RFC 3339 timestamp.",
+						},
+						attr.Email: schema.StringAttribute{
+							Computed:    true,
+							Description: "Email of the user.",
+						},
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the user, as used by signoz_user_role.",
+						},
+						attr.Name: schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the user.",
+						},
+						attr.Role: schema.StringAttribute{
+							Computed:    true,
+							Description: "Role of the user, such as ADMIN, EDITOR or VIEWER.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data usersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListUsers(ctx)
+	if err != nil {
+		addErr(&resp.Diagnostics, fmt.Errorf("unable to list SigNoz users: %s", err.Error()), SigNozUsers)
+		return
+	}
+
+	sort.SliceStable(users, func(i, j int) bool {
+		return users[i].Email < users[j].Email
+	})
+
+	// Set state values from retrieved data.
+	data.Users = make([]userModel, 0, len(users))
+	for _, user := range users {
+		data.Users = append(data.Users, userModel{
+			CreatedAt: types.StringValue(user.CreatedAtString()),
+			Email:     types.StringValue(user.Email),
+			ID:        types.StringValue(user.ID),
+			Name:      types.StringValue(user.Name),
+			Role:      types.StringValue(user.Role),
+		})
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
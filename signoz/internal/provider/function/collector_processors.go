@@ -0,0 +1,147 @@
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &collectorProcessorsFunction{}
+)
+
+// NewCollectorProcessorsFunction is a helper function to simplify the provider implementation.
+func NewCollectorProcessorsFunction() function.Function {
+	return &collectorProcessorsFunction{}
+}
+
+// collectorProcessorsFunction is the function implementation.
+type collectorProcessorsFunction struct{}
+
+// Metadata returns the function name.
+func (f *collectorProcessorsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "collector_processors"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *collectorProcessorsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts OpenTelemetry collector processors to log pipeline processors.",
+		Description: "Converts the log processors of an OpenTelemetry collector configuration to the processor list of " +
+			"signoz_logs_pipeline, to move log parsing from the collector to SigNoz. Supported processors are: " +
+			strings.Join(model.CollectorProcessors, ", ") + ". Of transform, the set, delete_key and merge_maps with " +
+			"ParseJSON or ExtractPatterns statements without where clauses are supported. Processors are converted in " +
+			"the order of their IDs. Anything else fails the conversion.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "processors",
+				Description: "JSON object of the processors keyed by ID, such as the processors section of the collector configuration.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+// Run converts the processors.
+func (f *collectorProcessorsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var processors string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &processors))
+	if resp.Error != nil {
+		return
+	}
+
+	var config map[string]interface{}
+	if err := json.Unmarshal([]byte(processors), &config); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "invalid processors: "+err.Error())
+		return
+	}
+
+	operators, err := model.ProcessorsFromCollector(config)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "unable to convert processors: "+err.Error())
+		return
+	}
+
+	elemTypes := make([]attr.Type, 0, len(operators))
+	elems := make([]attr.Value, 0, len(operators))
+	for _, operator := range operators {
+		processor, diags := processorValue(ctx, operator)
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+		if resp.Error != nil {
+			return
+		}
+		elemTypes = append(elemTypes, processor.Type(ctx))
+		elems = append(elems, processor)
+	}
+
+	result, diags := types.TupleValue(elemTypes, elems)
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.DynamicValue(result)))
+}
+
+// processorValue - Returns the processor object of the operator, setting only
+// the non-empty fields so that the defaults of the pipeline resource apply.
+func processorValue(ctx context.Context, operator model.PipelineOperator) (types.Object, diag.Diagnostics) {
+	fields := map[string]string{
+		"parse_from": operator.ParseFrom,
+		"parse_to":   operator.ParseTo,
+		"on_error":   operator.OnError,
+		"regex":      operator.Regex,
+		"field":      operator.Field,
+		"value":      operator.Value,
+		"from":       operator.From,
+		"to":         operator.To,
+	}
+	for key, value := range map[string]*model.PipelineParseFrom{
+		"trace_id":    operator.TraceID,
+		"span_id":     operator.SpanID,
+		"trace_flags": operator.TraceFlags,
+	} {
+		if value != nil {
+			fields[key] = value.ParseFrom
+		}
+	}
+
+	attrTypes := map[string]attr.Type{}
+	attrs := map[string]attr.Value{}
+	for key, value := range fields {
+		if value != "" {
+			attrTypes[key] = types.StringType
+			attrs[key] = types.StringValue(value)
+		}
+	}
+	if len(operator.Mapping) > 0 {
+		mapping, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, operator.Mapping)
+		if diags.HasError() {
+			return types.Object{}, diags
+		}
+		attrTypes["mapping"] = mapping.Type(ctx)
+		attrs["mapping"] = mapping
+	}
+	if operator.Type == model.PipelineProcessorSeverityParser {
+		attrTypes["overwrite_text"] = types.BoolType
+		attrs["overwrite_text"] = types.BoolValue(operator.OverwriteText)
+	}
+
+	body, diags := types.ObjectValue(attrTypes, attrs)
+	if diags.HasError() {
+		return types.Object{}, diags
+	}
+
+	return types.ObjectValue(
+		map[string]attr.Type{"name": types.StringType, "enabled": types.BoolType, operator.Type: body.Type(ctx)},
+		map[string]attr.Value{"name": types.StringValue(operator.Name), "enabled": types.BoolValue(operator.Enabled), operator.Type: body},
+	)
+}
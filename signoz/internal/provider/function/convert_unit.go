@@ -0,0 +1,71 @@
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &convertUnitFunction{}
+)
+
+// NewConvertUnitFunction is a helper function to simplify the provider implementation.
+func NewConvertUnitFunction() function.Function {
+	return &convertUnitFunction{}
+}
+
+// convertUnitFunction is the function implementation.
+type convertUnitFunction struct{}
+
+// Metadata returns the function name.
+func (f *convertUnitFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "convert_unit"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *convertUnitFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a value between units.",
+		Description: "Converts the value from a unit to another unit of the same kind, e.g. 1.5 s to 1500 ms, such as to " +
+			"write the target of an alert in the y-axis unit of its query. Units of different kinds, such as ms and " +
+			"bytes, fail the conversion. Supported units are: " + strings.Join(model.Units, ", ") + ".",
+		Parameters: []function.Parameter{
+			function.Float64Parameter{
+				Name:        "value",
+				Description: "Value to convert.",
+			},
+			function.StringParameter{
+				Name:        "from",
+				Description: "Unit of the value, e.g. s.",
+			},
+			function.StringParameter{
+				Name:        "to",
+				Description: "Unit to convert the value to, e.g. ms.",
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+// Run converts the value.
+func (f *convertUnitFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value float64
+	var from, to string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value, &from, &to))
+	if resp.Error != nil {
+		return
+	}
+
+	converted, err := model.ConvertUnit(value, from, to)
+	if err != nil {
+		resp.Error = function.NewFuncError("unable to convert the value: " + err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, converted))
+}
@@ -0,0 +1,109 @@
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/customtypes"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &downtimeScheduleFunction{}
+)
+
+// NewDowntimeScheduleFunction is a helper function to simplify the provider implementation.
+func NewDowntimeScheduleFunction() function.Function {
+	return &downtimeScheduleFunction{}
+}
+
+// downtimeScheduleFunction is the function implementation.
+type downtimeScheduleFunction struct{}
+
+// Metadata returns the function name.
+func (f *downtimeScheduleFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "downtime_schedule"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *downtimeScheduleFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds a recurring planned downtime schedule.",
+		Description: "Returns the schedule JSON of a recurring planned downtime, as accepted by the SigNoz downtime schedules API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timezone",
+				Description: "IANA time zone of the schedule, e.g. Europe/Berlin.",
+			},
+			function.StringParameter{
+				Name:        "start_time",
+				Description: "First occurrence of the downtime in RFC 3339 format, e.g. 2024-01-01T02:00:00Z.",
+			},
+			function.StringParameter{
+				Name:        "duration",
+				Description: "Duration of each occurrence, e.g. 2h.",
+			},
+			function.StringParameter{
+				Name:        "repeat_type",
+				Description: "How often the downtime repeats. Possible values are: " + strings.Join(model.DowntimeRepeatTypes, ", ") + ".",
+			},
+			function.ListParameter{
+				Name:        "repeat_on",
+				ElementType: types.StringType,
+				Description: "Weekdays the downtime repeats on, e.g. [\"saturday\", \"sunday\"]. Required for weekly schedules and must be empty otherwise.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the schedule.
+func (f *downtimeScheduleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		timezone, startTime, duration, repeatType string
+		repeatOn                                  []string
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timezone, &startTime, &duration, &repeatType, &repeatOn))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := customtypes.NormalizeDuration(duration)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, "invalid duration: "+err.Error())
+		return
+	}
+	for i := range repeatOn {
+		repeatOn[i] = strings.ToLower(repeatOn[i])
+	}
+	if repeatOn == nil {
+		repeatOn = []string{}
+	}
+
+	schedule := model.DowntimeSchedule{
+		Timezone: timezone,
+		Recurrence: &model.DowntimeRecurrence{
+			StartTime:  startTime,
+			Duration:   normalized,
+			RepeatType: repeatType,
+			RepeatOn:   repeatOn,
+		},
+	}
+	if err := schedule.Validate(); err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result, err := json.Marshal(schedule)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(result)))
+}
@@ -0,0 +1,59 @@
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/customtypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &durationFunction{}
+)
+
+// NewDurationFunction is a helper function to simplify the provider implementation.
+func NewDurationFunction() function.Function {
+	return &durationFunction{}
+}
+
+// durationFunction is the function implementation.
+type durationFunction struct{}
+
+// Metadata returns the function name.
+func (f *durationFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *durationFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalizes a duration.",
+		Description: "Returns the duration in the canonical format used by SigNoz, e.g. 5m becomes 5m0s and 1h becomes 1h0m0s.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "duration",
+				Description: "Duration to normalize, e.g. 90s, 5m or 1h30m.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run normalizes the duration.
+func (f *durationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := customtypes.NormalizeDuration(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "invalid duration: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
@@ -0,0 +1,102 @@
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &mergeWidgetsFunction{}
+)
+
+//nolint:gochecknoglobals
+var mergeWidgetsReturnTypes = map[string]tfattr.Type{
+	attr.Widgets: types.StringType,
+	attr.Layout:  types.StringType,
+}
+
+// NewMergeWidgetsFunction is a helper function to simplify the provider implementation.
+func NewMergeWidgetsFunction() function.Function {
+	return &mergeWidgetsFunction{}
+}
+
+// mergeWidgetsFunction is the function implementation.
+type mergeWidgetsFunction struct{}
+
+// Metadata returns the function name.
+func (f *mergeWidgetsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "merge_widgets"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *mergeWidgetsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merges dashboard widgets.",
+		Description: "Merges widget libraries into the widgets and layout of a dashboard. Widgets sharing an ID are deep merged, " +
+			"with later sources taking precedence. The layout of each source is moved below the previous sources, " +
+			"and widgets without a layout are placed in rows of two. Returns an object with the widgets and layout JSON strings.",
+		VariadicParameter: function.StringParameter{
+			Name:        "sources",
+			Description: "JSON array of widgets, or JSON object with widgets and layout arrays.",
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: mergeWidgetsReturnTypes,
+		},
+	}
+}
+
+// Run merges the widgets.
+func (f *mergeWidgetsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var inputs []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &inputs))
+	if resp.Error != nil {
+		return
+	}
+
+	sources := make([]model.WidgetSource, 0, len(inputs))
+	for i, input := range inputs {
+		source, err := model.ParseWidgetSource(input)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid source %d: %s", i+1, err))
+			return
+		}
+		sources = append(sources, *source)
+	}
+
+	widgets, layout, err := model.MergeWidgets(sources)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	widgetsJSON, err := json.Marshal(widgets)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	layoutJSON, err := json.Marshal(layout)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(mergeWidgetsReturnTypes, map[string]tfattr.Value{
+		attr.Widgets: types.StringValue(string(widgetsJSON)),
+		attr.Layout:  types.StringValue(string(layoutJSON)),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
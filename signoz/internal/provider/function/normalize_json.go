@@ -0,0 +1,59 @@
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &normalizeJSONFunction{}
+)
+
+// NewNormalizeJSONFunction is a helper function to simplify the provider implementation.
+func NewNormalizeJSONFunction() function.Function {
+	return &normalizeJSONFunction{}
+}
+
+// normalizeJSONFunction is the function implementation.
+type normalizeJSONFunction struct{}
+
+// Metadata returns the function name.
+func (f *normalizeJSONFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_json"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *normalizeJSONFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalizes a JSON string.",
+		Description: "Returns the JSON string with insignificant whitespace removed and object keys sorted, " +
+			"the same way the provider stores JSON attributes such as the alert condition and the dashboard widgets.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "json",
+				Description: "JSON string to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run normalizes the JSON string.
+func (f *normalizeJSONFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := structure.NormalizeJsonString(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "invalid JSON: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
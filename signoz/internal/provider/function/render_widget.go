@@ -0,0 +1,79 @@
+package function
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &renderWidgetFunction{}
+)
+
+// NewRenderWidgetFunction is a helper function to simplify the provider implementation.
+func NewRenderWidgetFunction() function.Function {
+	return &renderWidgetFunction{}
+}
+
+// renderWidgetFunction is the function implementation.
+type renderWidgetFunction struct{}
+
+// Metadata returns the function name.
+func (f *renderWidgetFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "render_widget"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *renderWidgetFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Instantiates a widget template.",
+		Description: "Instantiates a widget defined once, such as a latency, error rate or RPS panel shared by many dashboards. " +
+			"The [[name]] placeholders in the string values of the template are replaced with the variables, and the ID of " +
+			"the widget is set. Returns the widget JSON string, which can be combined with merge_widgets.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "template",
+				Description: "JSON object of the widget, with [[name]] placeholders.",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "ID of the widget, unique within the dashboard and referenced by its layout.",
+			},
+			function.MapParameter{
+				Name:        "variables",
+				ElementType: types.StringType,
+				Description: "Values of the placeholders by name. Every placeholder of the template must have a value.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run renders the widget.
+func (f *renderWidgetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var template, id string
+	var variables map[string]string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &template, &id, &variables))
+	if resp.Error != nil {
+		return
+	}
+
+	widget, err := model.RenderWidget(template, id, variables)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "invalid widget template: "+err.Error())
+		return
+	}
+
+	widgetJSON, err := json.Marshal(widget)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(widgetJSON)))
+}
@@ -0,0 +1,148 @@
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &thresholdConditionFunction{}
+)
+
+// NewThresholdConditionFunction is a helper function to simplify the provider implementation.
+func NewThresholdConditionFunction() function.Function {
+	return &thresholdConditionFunction{}
+}
+
+// thresholdConditionFunction is the function implementation.
+type thresholdConditionFunction struct{}
+
+// Metadata returns the function name.
+func (f *thresholdConditionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "threshold_condition"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *thresholdConditionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the condition of a threshold alert.",
+		Description: "Returns the v4 condition JSON of a threshold_rule alert evaluating a single query named A. " +
+			"The result can be passed to the condition attribute of signoz_alert.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "query",
+				Description: "Query builder query as a JSON object, e.g. built with jsonencode, or a PromQL expression. " +
+					"Its queryName is set to A.",
+			},
+			function.StringParameter{
+				Name:        "op",
+				Description: "Comparison with the target. Possible values are: " + strings.Join(utils.SortedKeys(model.ConditionOps), ", ") + ".",
+			},
+			function.Float64Parameter{
+				Name:        "target",
+				Description: "Threshold of the alert.",
+			},
+			function.StringParameter{
+				Name:        "match_type",
+				Description: "How the query result is compared over the evaluation window. Possible values are: " + strings.Join(utils.SortedKeys(model.ConditionMatchTypes), ", ") + ".",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name: "options",
+			Description: "Optional object with the unit of the query (unit) and of the target (target_unit), which " +
+				"must measure the same kind of value. " +
+				"At most one object can be given.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the condition.
+func (f *thresholdConditionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		query, op, matchType string
+		target               float64
+		options              []types.Dynamic
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &query, &op, &target, &matchType, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	threshold := model.ThresholdCondition{
+		Op:        op,
+		Target:    target,
+		MatchType: matchType,
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(query), "{") {
+		var builderQuery model.BuilderQuery
+		if err := json.Unmarshal([]byte(query), &builderQuery); err != nil {
+			resp.Error = function.NewArgumentFuncError(0, "invalid query JSON: "+err.Error())
+			return
+		}
+		threshold.BuilderQuery = &builderQuery
+	} else {
+		threshold.PromQL = query
+	}
+
+	if len(options) > 1 {
+		resp.Error = function.NewArgumentFuncError(4, "at most one options object can be given")
+		return
+	}
+	if len(options) == 1 {
+		values, err := stringAttributes(options[0], "unit", "target_unit")
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(4, err.Error())
+			return
+		}
+		threshold.Unit = values["unit"]
+		threshold.TargetUnit = values["target_unit"]
+	}
+
+	condition, err := threshold.ToCondition()
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result, err := json.Marshal(condition)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(result)))
+}
+
+// stringAttributes returns the string attributes of an object argument,
+// rejecting attributes other than the allowed ones.
+func stringAttributes(value types.Dynamic, allowed ...string) (map[string]string, error) {
+	object, ok := value.UnderlyingValue().(types.Object)
+	if !ok {
+		return nil, fmt.Errorf("expected an object, got %s", value.UnderlyingValue().Type(context.Background()))
+	}
+
+	result := map[string]string{}
+	for name, attrValue := range object.Attributes() {
+		if !utils.Contains(allowed, name) {
+			return nil, fmt.Errorf("unsupported attribute %q, expected one of: %s", name, strings.Join(allowed, ", "))
+		}
+		str, ok := attrValue.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("attribute %q must be a string", name)
+		}
+		result[name] = str.ValueString()
+	}
+
+	return result, nil
+}
@@ -4,11 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/customtypes"
 	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/normalize"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -25,7 +36,10 @@ import (
 )
 
 // jsonSemanticEqualityModifier implements a plan modifier that compares JSON strings semantically
-type jsonSemanticEqualityModifier struct{}
+type jsonSemanticEqualityModifier struct {
+	// resource provides the provider-wide ignored condition fields once configured.
+	resource *alertResource
+}
 
 func (m jsonSemanticEqualityModifier) Description(_ context.Context) string {
 	return "If the planned and state values are semantically equivalent JSON, use the state value to prevent unnecessary updates."
@@ -57,102 +71,160 @@ func (m jsonSemanticEqualityModifier) PlanModifyString(ctx context.Context, req
 		return
 	}
 
+	ignoredFields, diags := m.ignoredFields(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	disabledRules, diags := m.planList(ctx, req, attr.DisabledNormalizationRules)
+	resp.Diagnostics.Append(diags...)
+	version, diags := configuredVersion(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The condition is sent to SigNoz converted to the configured version.
+	planned, err := migrateCondition(req.PlanValue.ValueString(), version)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Unable to convert alert condition", err.Error())
+		return
+	}
+
 	// Compare JSONs semantically to handle formatting differences
-	tflog.Debug(ctx, "jsonSemanticEquality: About to call areJSONsSemanticallyEqual")
-	
-	result := areJSONsSemanticallyEqual(req.PlanValue.ValueString(), req.StateValue.ValueString())
-	
-	tflog.Debug(ctx, "jsonSemanticEquality: areJSONsSemanticallyEqual result", map[string]any{
-		"result": result,
-	})
-	
-	if result {
+	comparison, err := normalize.Compare(ctx, planned, req.StateValue.ValueString(), alertConditionOptions(m.client(), ignoredFields, disabledRules))
+	if err != nil {
+		tflog.Debug(ctx, "jsonSemanticEquality: Unable to compare conditions, keeping plan value", map[string]any{"error": err.Error()})
+		return
+	}
+
+	if comparison.Equal {
 		tflog.Debug(ctx, "jsonSemanticEquality: JSONs are semantically equal, using state value")
 		resp.PlanValue = req.StateValue
+
+		if suppressed := comparison.Suppressed(); len(suppressed) > 0 {
+			resp.Diagnostics.AddAttributeWarning(req.Path, "Ignored alert condition drift",
+				fmt.Sprintf("The condition differs from SigNoz only in ignored fields, so no update is planned: %s.",
+					strings.Join(suppressed, ", ")))
+		}
+		if c := m.client(); c != nil && c.NormalizationWarnings() {
+			if normalized := comparison.Normalized(); len(normalized) > 0 {
+				resp.Diagnostics.AddAttributeWarning(req.Path, "Normalized alert condition fields",
+					fmt.Sprintf("The condition differs from SigNoz in fields holding defaults, which are not compared, "+
+						"so no update is planned for them: %s.", strings.Join(normalized, ", ")))
+			}
+		}
 	} else {
 		tflog.Debug(ctx, "jsonSemanticEquality: JSONs are different, keeping plan value")
+		addJSONChangesWarning(ctx, &resp.Diagnostics, req.Path, req.StateValue.ValueString(), planned,
+			alertConditionOptions(m.client(), ignoredFields, disabledRules))
 	}
 }
 
-// normalizeJSON normalizes JSON by removing API-added default fields and ensuring consistent formatting
-func normalizeJSON(jsonStr string) (string, error) {
-	var data interface{}
-	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
-		return "", err
+// ignoredFields returns the condition fields ignored by the alert along with
+// the provider-wide ones.
+func (m jsonSemanticEqualityModifier) ignoredFields(ctx context.Context, req planmodifier.StringRequest) ([]string, diag.Diagnostics) {
+	var fields []string
+	if c := m.client(); c != nil {
+		fields = append(fields, c.IgnoreConditionFields()...)
 	}
 
-	// Remove API-added default fields that cause drift
-	normalized := removeDefaultFields(data)
+	alertFields, diags := m.planList(ctx, req, attr.IgnoreConditionFields)
 
-	// Marshal back to JSON with consistent formatting
-	bytes, err := json.Marshal(normalized)
-	if err != nil {
-		return "", err
+	return append(fields, alertFields...), diags
+}
+
+// planList returns the planned strings of the list attribute of the alert,
+// or nil if it is not known yet.
+func (m jsonSemanticEqualityModifier) planList(ctx context.Context, req planmodifier.StringRequest, name string) ([]string, diag.Diagnostics) {
+	var list types.List
+	diags := req.Plan.GetAttribute(ctx, path.Root(name), &list)
+	if diags.HasError() || list.IsNull() || list.IsUnknown() {
+		return nil, diags
 	}
 
-	return string(bytes), nil
+	var values []string
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+
+	return values, diags
 }
 
-// removeDefaultFields recursively removes API-added default fields that cause drift
-func removeDefaultFields(data interface{}) interface{} {
-	switch v := data.(type) {
-	case map[string]interface{}:
-		result := make(map[string]interface{})
-		for key, value := range v {
-			// Skip API-added default fields that cause drift
-			if isDefaultField(key, value) {
-				continue
-			}
-			result[key] = removeDefaultFields(value)
-		}
-		return result
-	case []interface{}:
-		result := make([]interface{}, len(v))
-		for i, item := range v {
-			result[i] = removeDefaultFields(item)
-		}
-		return result
-	default:
-		return v
+// client returns the client of the resource, or nil if not configured.
+func (m jsonSemanticEqualityModifier) client() *client.Client {
+	if m.resource == nil {
+		return nil
+	}
+
+	return m.resource.client
+}
+
+// alertConditionOptions returns the options normalizing alert conditions. The
+// rules disabled by the alert are applied along with the provider-wide ones.
+func alertConditionOptions(c *client.Client, ignoredFields, disabledRules []string) normalize.Options {
+	opts := normalize.Options{
+		Subsystem: normalize.SubsystemAlertCondition,
+		Defaults:  normalize.AlertConditionDefaults,
+		Transform: normalize.AlertConditionThresholds,
+		Scope:     normalize.AlertConditionScope(""),
+		Ignore:    ignoredFields,
+		Disabled:  disabledRules,
+	}
+	if c != nil {
+		opts.Disabled = append(append([]string(nil), c.DisabledNormalizationRules()...), disabledRules...)
+		opts.Cache = c.NormalizeCache()
 	}
+
+	return opts
 }
 
-// isDefaultField checks if a field is an API-added default that should be ignored
-func isDefaultField(key string, value interface{}) bool {
-	// Handle specific field types that can't be compared with ==
-	switch key {
-	case "groupBy":
-		// Check if it's an empty slice
-		if slice, ok := value.([]interface{}); ok {
-			return len(slice) == 0
-		}
-		return false
-	case "IsAnomaly":
-		return value == false
-	case "QueriesUsedInFormula":
-		return value == nil
-	case "absentFor":
-		return value == 0
-	case "alertOnAbsent":
-		return value == false
-	case "hidden":
-		return value == true
-	case "reduceTo", "spaceAggregation", "timeAggregation":
-		return value == ""
-	default:
-		return false
+// configuredVersion returns the alert version set in the configuration, or an
+// empty string if it is not set, in which case conditions are not converted.
+func configuredVersion(ctx context.Context, config tfsdk.Config) (string, diag.Diagnostics) {
+	var version types.String
+	diags := config.GetAttribute(ctx, path.Root(attr.Version), &version)
+	if version.IsUnknown() {
+		return "", diags
+	}
+
+	return version.ValueString(), diags
+}
+
+// migrateCondition returns the condition JSON converted to the version. It is
+// returned as is if its version is not detected or is already the version.
+func migrateCondition(condition, version string) (string, error) {
+	if condition == "" || version == "" {
+		return condition, nil
+	}
+
+	var c model.Condition
+	if err := json.Unmarshal([]byte(condition), &c); err != nil {
+		return "", err
+	}
+	if detected := c.Version(); detected == "" || detected == version {
+		return condition, nil
+	}
+
+	migrated, err := c.Migrate(version)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(migrated)
+	if err != nil {
+		return "", err
 	}
+
+	return string(b), nil
 }
 
-func jsonSemanticEquality() planmodifier.String {
-	return jsonSemanticEqualityModifier{}
+func jsonSemanticEquality(r *alertResource) planmodifier.String {
+	return jsonSemanticEqualityModifier{resource: r}
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &alertResource{}
-	_ resource.ResourceWithConfigure   = &alertResource{}
-	_ resource.ResourceWithImportState = &alertResource{}
+	_ resource.Resource                   = &alertResource{}
+	_ resource.ResourceWithConfigure      = &alertResource{}
+	_ resource.ResourceWithImportState    = &alertResource{}
+	_ resource.ResourceWithModifyPlan     = &alertResource{}
+	_ resource.ResourceWithUpgradeState   = &alertResource{}
+	_ resource.ResourceWithValidateConfig = &alertResource{}
 )
 
 // NewAlertResource is a helper function to simplify the provider implementation.
@@ -167,27 +239,69 @@ type alertResource struct {
 
 // alertResourceModel maps the resource schema data.
 type alertResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Alert             types.String `tfsdk:"alert"`
-	AlertType         types.String `tfsdk:"alert_type"`
-	BroadcastToAll    types.Bool   `tfsdk:"broadcast_to_all"`
-	Condition         types.String `tfsdk:"condition"`
-	Description       types.String `tfsdk:"description"`
-	Disabled          types.Bool   `tfsdk:"disabled"`
-	EvalWindow        types.String `tfsdk:"eval_window"`
-	Frequency         types.String `tfsdk:"frequency"`
-	Labels            types.Map    `tfsdk:"labels"`
-	PreferredChannels types.List   `tfsdk:"preferred_channels"`
-	RuleType          types.String `tfsdk:"rule_type"`
-	Severity          types.String `tfsdk:"severity"`
-	Source            types.String `tfsdk:"source"`
-	State             types.String `tfsdk:"state"`
-	Summary           types.String `tfsdk:"summary"`
-	Version           types.String `tfsdk:"version"`
-	CreateAt          types.String `tfsdk:"create_at"`
-	CreateBy          types.String `tfsdk:"create_by"`
-	UpdateAt          types.String `tfsdk:"update_at"`
-	UpdateBy          types.String `tfsdk:"update_by"`
+	ID                         types.String                    `tfsdk:"id"`
+	AbsentFor                  types.Int64                     `tfsdk:"absent_for"`
+	AdoptExisting              types.Bool                      `tfsdk:"adopt_existing"`
+	Alert                      types.String                    `tfsdk:"alert"`
+	AlertOnAbsent              types.Bool                      `tfsdk:"alert_on_absent"`
+	AlertType                  types.String                    `tfsdk:"alert_type"`
+	BroadcastToAll             types.Bool                      `tfsdk:"broadcast_to_all"`
+	ClickHouseQueries          map[string]alertQueryModel      `tfsdk:"clickhouse_queries"`
+	CloneFrom                  types.String                    `tfsdk:"clone_from"`
+	Condition                  types.String                    `tfsdk:"condition"`
+	Description                types.String                    `tfsdk:"description"`
+	Disabled                   types.Bool                      `tfsdk:"disabled"`
+	DisabledNormalizationRules types.List                      `tfsdk:"disabled_normalization_rules"`
+	EvalWindow                 customtypes.Duration            `tfsdk:"eval_window"`
+	Frequency                  customtypes.Duration            `tfsdk:"frequency"`
+	IgnoreConditionFields      types.List                      `tfsdk:"ignore_condition_fields"`
+	Labels                     types.Map                       `tfsdk:"labels"`
+	MatchType                  types.String                    `tfsdk:"match_type"`
+	NotificationSettings       *alertNotificationSettingsModel `tfsdk:"notification_settings"`
+	Op                         types.String                    `tfsdk:"op"`
+	PreferredChannels          customtypes.UnorderedList       `tfsdk:"preferred_channels"`
+	PromQLQueries              map[string]alertQueryModel      `tfsdk:"promql_queries"`
+	RuleType                   types.String                    `tfsdk:"rule_type"`
+	SelectedQuery              types.String                    `tfsdk:"selected_query"`
+	Severity                   types.String                    `tfsdk:"severity"`
+	SeverityChannels           types.Map                       `tfsdk:"severity_channels"`
+	Source                     types.String                    `tfsdk:"source"`
+	State                      types.String                    `tfsdk:"state"`
+	Summary                    types.String                    `tfsdk:"summary"`
+	Target                     types.Float64                   `tfsdk:"target"`
+	TargetUnit                 types.String                    `tfsdk:"target_unit"`
+	Thresholds                 []alertThresholdModel           `tfsdk:"thresholds"`
+	ValidateTemplates          types.Bool                      `tfsdk:"validate_templates"`
+	YAxisUnit                  types.String                    `tfsdk:"y_axis_unit"`
+	Version                    types.String                    `tfsdk:"version"`
+	CreateAt                   types.String                    `tfsdk:"create_at"`
+	CreateBy                   types.String                    `tfsdk:"create_by"`
+	UpdateAt                   types.String                    `tfsdk:"update_at"`
+	UpdateBy                   types.String                    `tfsdk:"update_by"`
+}
+
+// alertNotificationSettingsModel maps the notification settings of the alert.
+type alertNotificationSettingsModel struct {
+	GroupBy        types.List           `tfsdk:"group_by"`
+	RepeatInterval customtypes.Duration `tfsdk:"repeat_interval"`
+}
+
+// alertQueryModel maps a ClickHouse or PromQL query of the alert.
+type alertQueryModel struct {
+	Disabled types.Bool   `tfsdk:"disabled"`
+	Legend   types.String `tfsdk:"legend"`
+	Query    types.String `tfsdk:"query"`
+}
+
+// alertThresholdModel maps a threshold of the alert.
+type alertThresholdModel struct {
+	Channels       types.List    `tfsdk:"channels"`
+	MatchType      types.String  `tfsdk:"match_type"`
+	Name           types.String  `tfsdk:"name"`
+	Op             types.String  `tfsdk:"op"`
+	RecoveryTarget types.Float64 `tfsdk:"recovery_target"`
+	Target         types.Float64 `tfsdk:"target"`
+	TargetUnit     types.String  `tfsdk:"target_unit"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -219,19 +333,44 @@ func (r *alertResource) Metadata(_ context.Context, req resource.MetadataRequest
 // Schema defines the schema for the resource.
 func (r *alertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Creates and manages alert resources in SigNoz.",
 		Attributes: map[string]schema.Attribute{
+			attr.AbsentFor: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Minutes without data after which the alert fires when alert_on_absent is true, set as absentFor in the condition.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			attr.AdoptExisting: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Whether to adopt an existing alert with the same name on create, updating it with the " +
+					"configuration, instead of creating a duplicate. Creating fails if several alerts have the name. " +
+					"By default, it is false.",
+				Default: booldefault.StaticBool(false),
+			},
 			attr.Alert: schema.StringAttribute{
 				Required:    true,
 				Description: "Name of the alert.",
 			},
+			attr.AlertOnAbsent: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the alert fires when the query returns no data for absent_for minutes, set as alertOnAbsent in the condition.",
+			},
 			attr.AlertType: schema.StringAttribute{
-				Required: true,
-				Description: fmt.Sprintf("Type of the alert. Possible values are: %s, %s, %s, and %s.",
+				Optional: true,
+				Computed: true,
+				Description: fmt.Sprintf("Type of the alert. Possible values are: %s, %s, %s, and %s. "+
+					"It is required unless clone_from is set.",
 					model.AlertTypeMetrics, model.AlertTypeLogs, model.AlertTypeTraces, model.AlertTypeExceptions),
 				Validators: []validator.String{
 					stringvalidator.OneOf(model.AlertTypes...),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			attr.BroadcastToAll: schema.BoolAttribute{
 				Optional: true,
@@ -239,11 +378,29 @@ func (r *alertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Whether to broadcast the alert to all the alerting channels. " +
 					"By default, the alert is only sent to the preferred channels.",
 			},
+			attr.ClickHouseQueries: schema.MapNestedAttribute{
+				Optional: true,
+				Description: "ClickHouse queries of the alert by name, set as compositeQuery.chQueries in the condition, " +
+					"whose queryType must be clickhouse_sql.",
+				NestedObject: alertQuerySchema("ClickHouse SQL query."),
+			},
+			attr.CloneFrom: schema.StringAttribute{
+				Optional: true,
+				Description: "ID of an existing alert whose values seed the unset attributes, including the condition, " +
+					"when the alert is created, e.g. to promote a hand-tuned alert to Terraform management. It must be " +
+					"known when planning, so it cannot reference an alert created in the same apply. It has no effect " +
+					"once the alert exists.",
+			},
 			attr.Condition: schema.StringAttribute{
-				Required:    true,
-				Description: "Condition of the alert.",
+				Optional: true,
+				Computed: true,
+				Description: "Condition of the alert. It is required unless clone_from is set. Planned changes are listed " +
+					"field by field in a warning. Either the legacy single target (target, op, matchType) or a thresholds " +
+					"array may be set; thresholds are sent with the v2alpha1 rule schema version, and the thresholds SigNoz " +
+					"derives from a legacy target are not reported as drift.",
 				PlanModifiers: []planmodifier.String{
-					jsonSemanticEquality(),
+					stringplanmodifier.UseStateForUnknown(),
+					jsonSemanticEquality(r),
 				},
 			},
 			attr.Description: schema.StringAttribute{
@@ -258,35 +415,103 @@ func (r *alertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Whether the alert is disabled.",
 				Default:     booldefault.StaticBool(false),
 			},
+			attr.DisabledNormalizationRules: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Rules not applied when comparing the condition with SigNoz, in addition to the provider's " +
+					"alert_disabled_normalization_rules. Each rule is named after the condition field it removes when it " +
+					"holds the default added by SigNoz, such as hidden for hidden set to true, which is compared once the " +
+					"rule is disabled. The rules only apply to the condition, its builder queries, and its v5 query specs " +
+					"and their aggregations, so that fields SigNoz adds elsewhere are compared as they are. Possible " +
+					"values are: " + strings.Join(normalize.AlertConditionRules(), ", ") + ".",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(normalize.AlertConditionRules()...)),
+				},
+			},
 			attr.EvalWindow: schema.StringAttribute{
+				CustomType:  customtypes.DurationType{},
 				Optional:    true,
 				Computed:    true,
-				Description: "The evaluation window of the alert. By default, it is 5m0s.",
+				Description: "The evaluation window of the alert. Equivalent durations such as 5m and 5m0s are considered equal. By default, it is 5m0s.",
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+h)?([0-9]+m)?([0-9]+s)?$`), "invalid alert evaluation window. It should be in format of 5m0s or 15m30s"),
 				},
 				Default: stringdefault.StaticString(alertDefaultEvalWindow),
 			},
 			attr.Frequency: schema.StringAttribute{
+				CustomType:  customtypes.DurationType{},
 				Optional:    true,
 				Computed:    true,
-				Description: "The frequency of the alert. By default, it is 1m0s.",
+				Description: "The frequency of the alert. Equivalent durations such as 1m and 1m0s are considered equal. By default, it is 1m0s.",
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+h)?([0-9]+m)?([0-9]+s)?$`), "invalid alert frequency. It should be in format of 1m0s or 10m30s"),
 				},
 				Default: stringdefault.StaticString(alertDefaultFrequency),
 			},
+			attr.IgnoreConditionFields: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Condition fields ignored when comparing the condition with SigNoz, in addition to the provider's " +
+					"alert_ignore_condition_fields. A key such as hidden matches the key at any depth, and a dotted path such as " +
+					"compositeQuery.builderQueries.*.stepInterval matches from the root of the condition.",
+			},
 			attr.Labels: schema.MapAttribute{
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
-				Description: "Labels of the alert. Severity is a required label.",
+				Description: "Labels of the alert. The severity label is set from the severity attribute. It may also be " +
+					"listed here, in which case it sets the severity attribute if unset, or must match it otherwise.",
+			},
+			attr.MatchType: schema.StringAttribute{
+				Optional: true,
+				Description: "How the query result is compared with the target over the evaluation window, set as matchType " +
+					"in the condition. Possible values are: " + strings.Join(utils.SortedKeys(model.ConditionMatchTypes), ", ") + ".",
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.SortedKeys(model.ConditionMatchTypes)...),
+				},
+			},
+			attr.NotificationSettings: schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Grouping and repetition of the notifications, to tune noisy alerts over many series. They " +
+					"require a SigNoz server supporting the v2alpha1 rule schema. The wait before the first notification " +
+					"of a group is not a rule setting in SigNoz and cannot be set.",
+				Attributes: map[string]schema.Attribute{
+					attr.GroupBy: schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Labels grouping the series into separate notifications.",
+					},
+					attr.RepeatInterval: schema.StringAttribute{
+						CustomType:  customtypes.DurationType{},
+						Optional:    true,
+						Description: "Interval at which a firing alert is notified again, e.g. 4h. By default, it is not notified again.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+h)?([0-9]+m)?([0-9]+s)?$`), "invalid repeat interval. It should be in format of 4h or 30m0s"),
+						},
+					},
+				},
+			},
+			attr.Op: schema.StringAttribute{
+				Optional: true,
+				Description: "Comparison with the target, set as op in the condition. Possible values are: " +
+					strings.Join(utils.SortedKeys(model.ConditionOps), ", ") + ".",
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.SortedKeys(model.ConditionOps)...),
+				},
 			},
 			attr.PreferredChannels: schema.ListAttribute{
+				CustomType:  customtypes.NewUnorderedListType(types.StringType),
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
-				Description: "Preferred channels of the alert. By default, it is empty.",
+				Description: "Preferred channels of the alert, in any order. By default, it is the channels of the " +
+					"severity in severity_channels, if any, or empty.",
+			},
+			attr.PromQLQueries: schema.MapNestedAttribute{
+				Optional: true,
+				Description: "PromQL queries of the alert by name, set as compositeQuery.promQueries in the condition, " +
+					"whose queryType must be promql.",
+				NestedObject: alertQuerySchema("PromQL query."),
 			},
 			attr.RuleType: schema.StringAttribute{
 				Optional: true,
@@ -297,13 +522,34 @@ func (r *alertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringvalidator.OneOf(model.AlertRuleTypes...),
 				},
 			},
+			attr.SelectedQuery: schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the query compared with the target, set as selectedQueryName in the condition.",
+			},
 			attr.Severity: schema.StringAttribute{
-				Required: true,
-				Description: fmt.Sprintf("Severity of the alert. Possible values are: %s, %s, %s, and %s.",
+				Optional: true,
+				Computed: true,
+				Description: fmt.Sprintf("Severity of the alert. Possible values are: %s, %s, %s, and %s. "+
+					"It is required unless clone_from is set or the labels hold a severity label.",
 					model.AlertSeverityInfo, model.AlertSeverityWarning, model.AlertSeverityError, model.AlertSeverityCritical),
 				Validators: []validator.String{
 					stringvalidator.OneOf(model.AlertSeverities...),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			attr.SeverityChannels: schema.MapAttribute{
+				Optional: true,
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				Description: "Channels notified per severity, e.g. critical to a PagerDuty channel and warning to a Slack " +
+					"channel. They are used as the preferred channels of the alert according to its severity, and as the " +
+					"channels of each threshold according to its name, unless those channels are set explicitly.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(model.AlertSeverities...)),
+				},
 			},
 			attr.Source: schema.StringAttribute{
 				Optional:    true,
@@ -319,14 +565,113 @@ func (r *alertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Summary of the alert.",
 				Default:     stringdefault.StaticString(alertDefaultSummary),
 			},
-			attr.Version: schema.StringAttribute{
+			attr.Target: schema.Float64Attribute{
 				Optional:    true,
-				Computed:    true,
-				Description: "Version of the alert. By default, it is v4.",
+				Description: "Target of the alert, set as target in the condition.",
+			},
+			attr.TargetUnit: schema.StringAttribute{
+				Optional: true,
+				Description: "Unit of the target, set as targetUnit in the condition. SigNoz converts the target to the " +
+					"y-axis unit before comparing it, so both must measure the same kind of value. Possible values are: " +
+					strings.Join(model.Units, ", ") + ".",
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.Units...),
+				},
+			},
+			attr.Thresholds: schema.ListNestedAttribute{
+				Optional: true,
+				Description: "Thresholds of the alert, each named after a severity and notifying its own channels, so that " +
+					"one rule covers e.g. both warning and critical. They are added to the condition, which must not set " +
+					"thresholds itself. SigNoz servers older than v0.96.0, which do not support the v2alpha1 rule " +
+					"schema, get a single threshold as the target of the condition instead.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.Channels: schema.ListAttribute{
+							Optional:    true,
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Channels notified when the threshold is crossed. By default, it is the channels " +
+								"of its severity in severity_channels, if any, or empty.",
+						},
+						attr.MatchType: schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Description: "How the query result is compared over the evaluation window. Possible values are: " +
+								strings.Join(utils.SortedKeys(model.ConditionMatchTypes), ", ") + ". By default, it is at_least_once.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(utils.SortedKeys(model.ConditionMatchTypes)...),
+							},
+							Default: stringdefault.StaticString(alertDefaultMatchType),
+						},
+						attr.Name: schema.StringAttribute{
+							Required: true,
+							Description: fmt.Sprintf("Severity of the threshold. Possible values are: %s, %s, %s, and %s.",
+								model.AlertSeverityInfo, model.AlertSeverityWarning, model.AlertSeverityError, model.AlertSeverityCritical),
+							Validators: []validator.String{
+								stringvalidator.OneOf(model.AlertSeverities...),
+							},
+						},
+						attr.Op: schema.StringAttribute{
+							Required: true,
+							Description: "Comparison with the target. Possible values are: " +
+								strings.Join(utils.SortedKeys(model.ConditionOps), ", ") + ".",
+							Validators: []validator.String{
+								stringvalidator.OneOf(utils.SortedKeys(model.ConditionOps)...),
+							},
+						},
+						attr.RecoveryTarget: schema.Float64Attribute{
+							Optional:    true,
+							Description: "Value the query result must cross back for the threshold to resolve. By default, it is the target.",
+						},
+						attr.Target: schema.Float64Attribute{
+							Required:    true,
+							Description: "Target of the threshold.",
+						},
+						attr.TargetUnit: schema.StringAttribute{
+							Optional:    true,
+							Description: "Unit of the target, of the same kind as the y-axis unit.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(model.Units...),
+							},
+						},
+					},
+				},
+			},
+			attr.YAxisUnit: schema.StringAttribute{
+				Optional: true,
+				Description: "Unit of the values returned by the queries, set as compositeQuery.unit in the condition. " +
+					"Without a target unit, the target is compared in this unit. Possible values are: " +
+					strings.Join(model.Units, ", ") + ".",
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.Units...),
+				},
+			},
+			attr.ValidateTemplates: schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether the templates of the labels, description and summary, such as {{ $value }} or " +
+					"{{ .Labels.service }}, are validated with the configuration. By default, they are only validated " +
+					"by SigNoz when the alert fires. Values are sent as they are either way.",
+			},
+			attr.Version: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Version of the alert payload. By default, it is detected from the shape of the condition " +
+					"(v5 for compositeQuery.queries, v4 or v3 for metrics builder queries with or without timeAggregation), " +
+					"then from the SigNoz server version (v4, or v3 for servers older than v0.38.0), and falls back to v4 " +
+					"when both detections fail. When set, v3 and v4 conditions are converted to the version before they are " +
+					"sent to SigNoz and compared with it, so the version can be bumped without rewriting the condition: v3 " +
+					"aggregate operators are split into timeAggregation and spaceAggregation, and v4 builder, PromQL and " +
+					"ClickHouse queries are moved to compositeQuery.queries. v4 conditions are converted back to v3 when " +
+					"their aggregations have a v3 equivalent, while v5 conditions cannot be converted back.",
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(regexp.MustCompile(`v\d+`), "alert version should be of the form v3, v4, etc."),
 				},
-				Default: stringdefault.StaticString(alertDefaultVersion),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			// computed.
 			attr.ID: schema.StringAttribute{
@@ -339,9 +684,6 @@ func (r *alertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			attr.State: schema.StringAttribute{
 				Computed:    true,
 				Description: "State of the alert.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
 			attr.CreateAt: schema.StringAttribute{
 				Computed:    true,
@@ -360,21 +702,286 @@ func (r *alertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			attr.UpdateAt: schema.StringAttribute{
 				Computed:    true,
 				Description: "Last update time of the alert.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
 			attr.UpdateBy: schema.StringAttribute{
 				Computed:    true,
 				Description: "Last updater of the alert.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
 		},
 	}
 }
 
+// alertQuerySchema returns the schema of a ClickHouse or PromQL query.
+func alertQuerySchema(queryDescription string) schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			attr.Disabled: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the query is disabled. By default, it is false.",
+				Default:     booldefault.StaticBool(false),
+			},
+			attr.Legend: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Legend of the query. By default, it is empty.",
+				Default:     stringdefault.StaticString(""),
+			},
+			attr.Query: schema.StringAttribute{
+				Required:    true,
+				Description: queryDescription,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates that the severity label, if set, matches the severity,
+// that the values lifted out of the condition are not set in both the
+// condition and their attribute, and that the templates are valid if asked.
+func (r *alertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var labels types.Map
+	var severity, condition, cloneFrom, version types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Labels), &labels)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Severity), &severity)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Condition), &condition)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.CloneFrom), &cloneFrom)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Version), &version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without an alert to clone, the seeded attributes must be configured. The
+	// severity may also be set through the labels. An unknown alert to clone is
+	// rejected by ModifyPlan.
+	label, hasLabel := labels.Elements()[attr.Severity].(types.String)
+	if cloneFrom.IsNull() {
+		for _, attribute := range []string{attr.AlertType, attr.Condition, attr.Severity} {
+			var value tfattr.Value
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+			if value != nil && value.IsNull() && (attribute != attr.Severity || !hasLabel) {
+				resp.Diagnostics.AddAttributeError(path.Root(attribute), "Missing required argument",
+					fmt.Sprintf("The argument %q is required unless %s is set.", attribute, attr.CloneFrom))
+			}
+		}
+	}
+	if hasLabel && !label.IsNull() && !label.IsUnknown() && !slices.Contains(model.AlertSeverities, label.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.Labels).AtMapKey(attr.Severity), "Invalid alert severity",
+			fmt.Sprintf("The severity label %q is not one of: %s.", label.ValueString(), strings.Join(model.AlertSeverities, ", ")))
+	}
+
+	var alert model.Alert
+	if !condition.IsNull() && !condition.IsUnknown() && alert.SetCondition(condition) == nil {
+		for _, attribute := range utils.SortedKeys(alertConditionKeys) {
+			var value tfattr.Value
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+			if _, ok := alert.ConditionValue(alertConditionKeys[attribute]); ok && value != nil && !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(attribute), "Conflicting alert condition",
+					fmt.Sprintf("%s is set in both the condition and the %s attribute. Remove it from the condition.",
+						alertConditionKeys[attribute], attribute))
+			}
+		}
+		if !version.IsUnknown() {
+			if _, err := alert.Condition.Migrate(version.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root(attr.Condition), "Unable to convert alert condition", err.Error())
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(validateAlertTemplates(ctx, req.Config, labels)...)
+	resp.Diagnostics.Append(validateAlertUnits(ctx, req.Config, &alert)...)
+
+	if severity.IsNull() || severity.IsUnknown() {
+		return
+	}
+
+	if !hasLabel || label.IsUnknown() || label.ValueString() == severity.ValueString() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(path.Root(attr.Labels).AtMapKey(attr.Severity), "Conflicting alert severity",
+		fmt.Sprintf("The severity label %q does not match the severity %q of the alert.", label.ValueString(), severity.ValueString()))
+}
+
+// validateAlertUnits validates that the target units, set through their
+// attribute or in the condition, can be converted to the y-axis unit. Units
+// unknown to the provider are not validated.
+func validateAlertUnits(ctx context.Context, config tfsdk.Config, alert *model.Alert) diag.Diagnostics {
+	var yAxisUnit, targetUnit types.String
+	var thresholds types.List
+	diags := config.GetAttribute(ctx, path.Root(attr.YAxisUnit), &yAxisUnit)
+	diags.Append(config.GetAttribute(ctx, path.Root(attr.TargetUnit), &targetUnit)...)
+	diags.Append(config.GetAttribute(ctx, path.Root(attr.Thresholds), &thresholds)...)
+	if diags.HasError() || yAxisUnit.IsUnknown() {
+		return diags
+	}
+
+	unit := func(value types.String, key string) (string, bool) {
+		if !value.IsNull() {
+			return value.ValueString(), !value.IsUnknown()
+		}
+		conditionValue, _ := alert.ConditionValue(key)
+		s, _ := conditionValue.(string)
+		return s, true
+	}
+	yAxis, _ := unit(yAxisUnit, model.ConditionKeyYAxisUnit)
+	if !slices.Contains(model.Units, yAxis) {
+		return diags
+	}
+
+	targets := map[string]path.Path{}
+	if target, ok := unit(targetUnit, model.ConditionKeyTargetUnit); ok {
+		targets[target] = path.Root(attr.TargetUnit)
+	}
+	if !thresholds.IsNull() && !thresholds.IsUnknown() {
+		var models []alertThresholdModel
+		diags.Append(thresholds.ElementsAs(ctx, &models, false)...)
+		for i, threshold := range models {
+			if !threshold.TargetUnit.IsUnknown() {
+				targets[threshold.TargetUnit.ValueString()] = path.Root(attr.Thresholds).AtListIndex(i).AtName(attr.TargetUnit)
+			}
+		}
+	}
+
+	for _, target := range utils.SortedKeys(targets) {
+		if !slices.Contains(model.Units, target) {
+			continue
+		}
+		if err := model.CompatibleUnits(target, yAxis); err != nil {
+			diags.AddAttributeError(targets[target], "Incompatible alert units",
+				fmt.Sprintf("The target unit cannot be compared with the y-axis unit, so the alert would never fire as "+
+					"expected: %s.", err))
+		}
+	}
+
+	return diags
+}
+
+// validateAlertTemplates validates the templates of the labels, description
+// and summary if validate_templates is set, reporting each invalid value at
+// its label key or attribute.
+func validateAlertTemplates(ctx context.Context, config tfsdk.Config, labels types.Map) diag.Diagnostics {
+	var validate types.Bool
+	diags := config.GetAttribute(ctx, path.Root(attr.ValidateTemplates), &validate)
+	if diags.HasError() || !validate.ValueBool() {
+		return diags
+	}
+
+	for _, key := range utils.SortedKeys(labels.Elements()) {
+		if value, ok := labels.Elements()[key].(types.String); ok && !value.IsUnknown() {
+			if err := model.ValidateAlertTemplate(value.ValueString()); err != nil {
+				diags.AddAttributeError(path.Root(attr.Labels).AtMapKey(key), "Invalid alert template",
+					fmt.Sprintf("The label %q is not a valid template: %s.", key, err))
+			}
+		}
+	}
+	for _, attribute := range []string{attr.Description, attr.Summary} {
+		var value types.String
+		diags.Append(config.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if err := model.ValidateAlertTemplate(value.ValueString()); !value.IsUnknown() && err != nil {
+			diags.AddAttributeError(path.Root(attribute), "Invalid alert template",
+				fmt.Sprintf("The %s is not a valid template: %s.", attribute, err))
+		}
+	}
+
+	return diags
+}
+
+// ModifyPlan seeds the unset attributes of a new alert from the alert to clone,
+// and plans the severity set through the labels.
+func (r *alertResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Only alerts being created are cloned.
+	if req.State.Raw.IsNull() && r.client != nil {
+		r.clone(ctx, req, resp)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The severity label takes precedence over the cloned severity.
+	var labels types.Map
+	var severity types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Labels), &labels)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Severity), &severity)...)
+	if resp.Diagnostics.HasError() || !severity.IsNull() {
+		return
+	}
+	if label, ok := labels.Elements()[attr.Severity].(types.String); ok && !label.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr.Severity), label)...)
+	}
+}
+
+// clone seeds the unset attributes of the plan from the alert to clone, if any.
+func (r *alertResource) clone(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var cloneFrom types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.CloneFrom), &cloneFrom)...)
+	if resp.Diagnostics.HasError() || cloneFrom.IsNull() {
+		return
+	}
+	// The alert to clone seeds the plan, so it cannot be created in the same
+	// apply, as Create would otherwise get unknown seeded attributes.
+	if cloneFrom.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.CloneFrom), "Unknown alert to clone",
+			"The ID of the alert to clone must be known when planning, as its values seed the plan. Reference an "+
+				"existing alert, or create the alert to clone in an earlier apply, e.g. with -target.")
+		return
+	}
+
+	source, err := r.client.GetAlert(ctx, cloneFrom.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.CloneFrom), "Unable to read the alert to clone", err.Error())
+		return
+	}
+
+	// The condition keys managed through their configured attribute are left
+	// out of the cloned condition.
+	var managedKeys []string
+	for _, attribute := range utils.SortedKeys(alertConditionKeys) {
+		var value tfattr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if value != nil && !value.IsNull() {
+			managedKeys = append(managedKeys, alertConditionKeys[attribute])
+		}
+	}
+	condition, err := normalize.Value(ctx, source.ConditionWithout(managedKeys...).Map(), alertConditionOptions(r.client, nil, nil))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.CloneFrom), "Unable to clone the alert condition", err.Error())
+		return
+	}
+
+	labels, diags := source.LabelsToTerraform(r.client.ManagedAlertLabels(), false)
+	resp.Diagnostics.Append(diags...)
+	preferredChannels, diags := source.PreferredChannelsToTerraform()
+	resp.Diagnostics.Append(diags...)
+
+	seeds := map[string]tfattr.Value{
+		attr.AlertType:         types.StringValue(source.AlertType),
+		attr.BroadcastToAll:    types.BoolValue(source.BroadcastToAll),
+		attr.Condition:         types.StringValue(condition.JSON),
+		attr.Description:       types.StringValue(source.Annotations.Description),
+		attr.EvalWindow:        customtypes.NewDurationValue(source.EvalWindow),
+		attr.Frequency:         customtypes.NewDurationValue(source.Frequency),
+		attr.Labels:            labels,
+		attr.PreferredChannels: customtypes.NewUnorderedList(preferredChannels),
+		attr.RuleType:          types.StringValue(source.RuleType),
+		attr.Severity:          types.StringValue(source.Labels[attr.Severity]),
+		attr.Summary:           types.StringValue(source.Annotations.Summary),
+	}
+	for _, attribute := range utils.SortedKeys(seeds) {
+		var value tfattr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if value == nil || !value.IsNull() {
+			continue
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), seeds[attribute])...)
+	}
+
+	tflog.Debug(ctx, "Seeded alert from the alert to clone", map[string]any{"alert": cloneFrom.ValueString()})
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan.
@@ -406,13 +1013,35 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	alertPayload.SetLabels(plan.Labels, plan.Severity)
-	alertPayload.SetPreferredChannels(plan.PreferredChannels)
+	version, diags := configuredVersion(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	alertPayload.Condition, err = alertPayload.Condition.Migrate(version)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozAlert)
+		return
+	}
+
+	setSeverity(&plan)
+	alertPayload.SetLabels(plan.Labels, plan.Severity, r.client.ManagedAlertLabels())
+	plan.Severity = types.StringValue(alertPayload.Labels[attr.Severity])
+	alertPayload.SetNotificationSettings(notificationSettingsFromTerraform(ctx, plan.NotificationSettings, &resp.Diagnostics))
+	setConditionValues(alertPayload, plan)
+	resp.Diagnostics.Append(setChannels(ctx, alertPayload, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Debug(ctx, "Creating alert", map[string]any{"alert": alertPayload})
 
-	// Create new alert
-	alert, err := r.client.CreateAlert(ctx, alertPayload)
+	// Create new alert, or adopt the alert with the same name.
+	create := r.client.CreateAlert
+	if plan.AdoptExisting.ValueBool() {
+		create = r.client.CreateOrAdoptAlert
+	}
+	alert, err := create(ctx, alertPayload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating alert",
@@ -425,6 +1054,8 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Map response to schema and populate Computed attributes.
 	plan.ID = types.StringValue(alert.ID)
+	plan.Version = types.StringValue(alertPayload.Version)
+	resp.Diagnostics.Append(resolveChannels(ctx, &plan, alertPayload)...)
 	plan.Disabled = types.BoolValue(alert.Disabled)
 	plan.Source = types.StringValue(alert.Source)
 	plan.State = types.StringValue(alert.State)
@@ -433,6 +1064,14 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.UpdateAt = types.StringValue(alert.UpdateAt)
 	plan.UpdateBy = types.StringValue(alert.UpdateBy)
 
+	var ignoredFields, disabledRules []string
+	resp.Diagnostics.Append(plan.IgnoreConditionFields.ElementsAs(ctx, &ignoredFields, false)...)
+	resp.Diagnostics.Append(plan.DisabledNormalizationRules.ElementsAs(ctx, &disabledRules, false)...)
+	ignoredFields = append(ignoredFields, r.client.IgnoreConditionFields()...)
+
+	// Store the values recorded by SigNoz for the creation.
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan, version, ignoredFields, disabledRules)...)
+
 	// Set state to populated data.
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -465,8 +1104,8 @@ func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.BroadcastToAll = types.BoolValue(alert.BroadcastToAll)
 	state.Description = types.StringValue(alert.Annotations.Description)
 	state.Disabled = types.BoolValue(alert.Disabled)
-	state.EvalWindow = types.StringValue(alert.EvalWindow)
-	state.Frequency = types.StringValue(alert.Frequency)
+	state.EvalWindow = customtypes.NewDurationValue(alert.EvalWindow)
+	state.Frequency = customtypes.NewDurationValue(alert.Frequency)
 	state.RuleType = types.StringValue(alert.RuleType)
 	state.Severity = types.StringValue(alert.Labels[attr.Severity])
 	state.Source = types.StringValue(alert.Source)
@@ -478,17 +1117,70 @@ func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.UpdateAt = types.StringValue(alert.UpdateAt)
 	state.UpdateBy = types.StringValue(alert.UpdateBy)
 
-	state.Condition, err = alert.ConditionToTerraform()
-	if err != nil {
+	// Thresholds managed through their attribute, or found on import, are
+	// split from the condition.
+	if state.Thresholds != nil || (state.Condition.IsNull() && alert.HasThresholds()) {
+		thresholds, err := alert.Thresholds()
+		if err != nil {
+			addErr(&resp.Diagnostics, err, operationRead, SigNozAlert)
+			return
+		}
+		state.Thresholds, diag = thresholdsToTerraform(ctx, thresholds)
+		resp.Diagnostics.Append(diag...)
+	}
+
+	// The values managed through their attribute are split from the condition.
+	managedKeys := managedConditionKeys(state)
+	if state.Thresholds != nil && !alert.HasThresholds() {
+		// Servers which do not support thresholds store their legacy shape.
+		managedKeys = append(managedKeys, model.ConditionLegacyThresholdKeys...)
+	}
+	if err := readConditionValues(&state, alert); err != nil {
 		addErr(&resp.Diagnostics, err, operationRead, SigNozAlert)
 		return
 	}
+	alert.Condition = alert.ConditionWithout(managedKeys...)
+
+	if state.Condition.IsNull() {
+		// Imported alerts get their condition without the defaults added by
+		// SigNoz, so that the generated configuration only holds set fields.
+		result, err := normalize.Value(ctx, alert.Condition.Map(), alertConditionOptions(r.client, nil, nil))
+		if err != nil {
+			addErr(&resp.Diagnostics, err, operationRead, SigNozAlert)
+			return
+		}
+		state.Condition = types.StringValue(result.JSON)
+		if defaults := result.Paths(normalize.ReasonDefault); r.client.NormalizationWarnings() && len(defaults) > 0 {
+			resp.Diagnostics.AddAttributeWarning(path.Root(attr.Condition), "Normalized alert condition fields",
+				fmt.Sprintf("The fields holding defaults are left out of the imported condition: %s.", strings.Join(defaults, ", ")))
+		}
+	} else {
+		state.Condition, err = alert.ConditionToTerraform()
+		if err != nil {
+			addErr(&resp.Diagnostics, err, operationRead, SigNozAlert)
+			return
+		}
+	}
 
-	state.Labels, diag = alert.LabelsToTerraform()
+	// Imported alerts have no adoption setting yet.
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
+
+	// Keep the severity label only if it is part of the configured labels.
+	_, withSeverity := state.Labels.Elements()[attr.Severity]
+	state.Labels, diag = alert.LabelsToTerraform(r.client.ManagedAlertLabels(), withSeverity)
 	resp.Diagnostics.Append(diag...)
 
-	state.PreferredChannels, diag = alert.PreferredChannelsToTerraform()
+	preferredChannels, diag := alert.PreferredChannelsToTerraform()
 	resp.Diagnostics.Append(diag...)
+	state.PreferredChannels = customtypes.NewUnorderedList(preferredChannels)
+
+	// Notification settings are only read once managed through their attribute.
+	if state.NotificationSettings != nil {
+		state.NotificationSettings, diag = notificationSettingsToTerraform(ctx, alert.NotificationSettings)
+		resp.Diagnostics.Append(diag...)
+	}
 
 	// Set refreshed state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -540,32 +1232,63 @@ func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	alertUpdate.SetLabels(plan.Labels, plan.Severity)
-	alertUpdate.SetPreferredChannels(plan.PreferredChannels)
+	version, diags := configuredVersion(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	alertUpdate.Condition, err = alertUpdate.Condition.Migrate(version)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, SigNozAlert)
+		return
+	}
 
-	// Update existing alert.
-	err = r.client.UpdateAlert(ctx, state.ID.ValueString(), alertUpdate)
+	setSeverity(&plan)
+	alertUpdate.SetLabels(plan.Labels, plan.Severity, r.client.ManagedAlertLabels())
+	plan.Severity = types.StringValue(alertUpdate.Labels[attr.Severity])
+	alertUpdate.SetNotificationSettings(notificationSettingsFromTerraform(ctx, plan.NotificationSettings, &resp.Diagnostics))
+	setConditionValues(alertUpdate, plan)
+	resp.Diagnostics.Append(setChannels(ctx, alertUpdate, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing alert. Toggling disabled alone is a patch, which leaves the
+	// stored condition untouched.
+	if onlyDisabledChanged(plan, state) {
+		err = r.client.SetAlertDisabled(ctx, state.ID.ValueString(), plan.Disabled.ValueBool())
+	} else {
+		err = r.client.UpdateAlert(ctx, state.ID.ValueString(), alertUpdate)
+	}
 	if err != nil {
 		addErr(&resp.Diagnostics, err, operationUpdate, SigNozAlert)
 		return
 	}
 
-	// Instead of fetching fresh state (which causes timestamp inconsistencies),
-	// we'll use the plan data and preserve the original timestamps from state.
-	// This avoids the "inconsistent result" error while maintaining data integrity.
+	var ignoredFields []string
+	resp.Diagnostics.Append(plan.IgnoreConditionFields.ElementsAs(ctx, &ignoredFields, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ignoredFields = append(ignoredFields, r.client.IgnoreConditionFields()...)
 
-	// Debug: Log what we're comparing
-	tflog.Debug(ctx, "Update: Comparing condition values", map[string]any{
-		"planCondition":  plan.Condition.ValueString(),
-		"stateCondition": state.Condition.ValueString(),
-		"areEqual":       plan.Condition.ValueString() == state.Condition.ValueString(),
-	})
+	var disabledRules []string
+	resp.Diagnostics.Append(plan.DisabledNormalizationRules.ElementsAs(ctx, &disabledRules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Only update condition if the user explicitly changed it in their config
 	// This prevents drift from API formatting differences
 	if !state.Condition.IsNull() && !state.Condition.IsUnknown() {
 		// Compare JSON semantically to handle formatting differences
-		if areJSONsSemanticallyEqual(plan.Condition.ValueString(), state.Condition.ValueString()) {
+		condition, err := migrateCondition(plan.Condition.ValueString(), version)
+		if err != nil {
+			addErr(&resp.Diagnostics, err, operationUpdate, SigNozAlert)
+			return
+		}
+		comparison, err := normalize.Compare(ctx, condition, state.Condition.ValueString(), alertConditionOptions(r.client, ignoredFields, disabledRules))
+		if err == nil && comparison.Equal {
 			plan.Condition = state.Condition
 		}
 		// If they're semantically different, let the plan value go through (user made a change)
@@ -579,6 +1302,10 @@ func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.UpdateBy = state.UpdateBy
 	plan.Source = state.Source
 	plan.State = state.State
+	resp.Diagnostics.Append(resolveChannels(ctx, &plan, alertUpdate)...)
+
+	// Store the values recorded by SigNoz for the update.
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan, version, ignoredFields, disabledRules)...)
 
 	// Set refreshed state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -587,54 +1314,426 @@ func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 }
 
-// areJSONsSemanticallyEqual compares two JSON strings semantically
-func areJSONsSemanticallyEqual(json1, json2 string) bool {
-	tflog.Debug(context.Background(), "areJSONsSemanticallyEqual: Starting comparison")
-	
-	var data1, data2 interface{}
-	
-	if err := json.Unmarshal([]byte(json1), &data1); err != nil {
-		tflog.Debug(context.Background(), "areJSONsSemanticallyEqual: Failed to unmarshal json1", map[string]any{"error": err.Error()})
-		return false
+// alertConditionKeys maps the attributes lifted out of the condition to their
+// condition keys.
+//
+//nolint:gochecknoglobals
+var alertConditionKeys = map[string]string{
+	attr.ClickHouseQueries: model.ConditionKeyCHQueries,
+	attr.PromQLQueries:     model.ConditionKeyPromQueries,
+	attr.AbsentFor:         model.ConditionKeyAbsentFor,
+	attr.AlertOnAbsent:     model.ConditionKeyAlertOnAbsent,
+	attr.MatchType:         model.ConditionKeyMatchType,
+	attr.Op:                model.ConditionKeyOp,
+	attr.SelectedQuery:     model.ConditionKeySelectedQuery,
+	attr.Target:            model.ConditionKeyTarget,
+	attr.TargetUnit:        model.ConditionKeyTargetUnit,
+	attr.Thresholds:        model.ConditionKeyThresholds,
+	attr.YAxisUnit:         model.ConditionKeyYAxisUnit,
+}
+
+// managedConditionKeys returns the condition keys managed through their
+// attribute, i.e. the ones whose attribute is set.
+func managedConditionKeys(m alertResourceModel) []string {
+	set := map[string]bool{
+		attr.ClickHouseQueries: m.ClickHouseQueries != nil,
+		attr.PromQLQueries:     m.PromQLQueries != nil,
+		attr.AbsentFor:         !m.AbsentFor.IsNull(),
+		attr.AlertOnAbsent:     !m.AlertOnAbsent.IsNull(),
+		attr.MatchType:         !m.MatchType.IsNull(),
+		attr.Op:                !m.Op.IsNull(),
+		attr.SelectedQuery:     !m.SelectedQuery.IsNull(),
+		attr.Target:            !m.Target.IsNull(),
+		attr.TargetUnit:        !m.TargetUnit.IsNull(),
+		attr.Thresholds:        m.Thresholds != nil,
+		attr.YAxisUnit:         !m.YAxisUnit.IsNull(),
 	}
-	
-	if err := json.Unmarshal([]byte(json2), &data2); err != nil {
-		tflog.Debug(context.Background(), "areJSONsSemanticallyEqual: Failed to unmarshal json2", map[string]any{"error": err.Error()})
-		return false
+
+	keys := make([]string, 0, len(set))
+	for _, attribute := range utils.SortedKeys(set) {
+		if set[attribute] {
+			keys = append(keys, alertConditionKeys[attribute])
+		}
+	}
+
+	return keys
+}
+
+// setSeverity sets the severity from the severity label, which takes
+// precedence over the planned severity. The label may only be known once
+// applying, in which case the severity is unknown or the prior one.
+func setSeverity(plan *alertResourceModel) {
+	if label, ok := plan.Labels.Elements()[attr.Severity].(types.String); ok && !label.IsNull() && !label.IsUnknown() {
+		plan.Severity = label
+	}
+}
+
+// setConditionValues merges the values set through their attribute into the
+// condition of the payload, with their API values.
+func setConditionValues(alertPayload *model.Alert, plan alertResourceModel) {
+	if alertPayload.Condition == nil {
+		alertPayload.Condition = &model.Condition{}
+	}
+	condition := alertPayload.Condition
+
+	if !plan.AbsentFor.IsNull() {
+		condition.AbsentFor = plan.AbsentFor.ValueInt64Pointer()
+	}
+	if !plan.AlertOnAbsent.IsNull() {
+		condition.AlertOnAbsent = plan.AlertOnAbsent.ValueBoolPointer()
+	}
+	if plan.ClickHouseQueries != nil {
+		alertPayload.SetConditionQueries(model.ConditionKeyCHQueries, queriesFromTerraform(plan.ClickHouseQueries))
+	}
+	if plan.PromQLQueries != nil {
+		alertPayload.SetConditionQueries(model.ConditionKeyPromQueries, queriesFromTerraform(plan.PromQLQueries))
+	}
+	if !plan.MatchType.IsNull() {
+		condition.MatchType = utils.Ptr(model.ConditionMatchTypes[plan.MatchType.ValueString()])
+	}
+	if !plan.Op.IsNull() {
+		condition.Op = utils.Ptr(model.ConditionOps[plan.Op.ValueString()])
+	}
+	if !plan.SelectedQuery.IsNull() {
+		condition.SelectedQueryName = plan.SelectedQuery.ValueStringPointer()
+	}
+	if !plan.Target.IsNull() {
+		condition.Target = plan.Target.ValueFloat64Pointer()
+	}
+	if !plan.TargetUnit.IsNull() {
+		condition.TargetUnit = plan.TargetUnit.ValueStringPointer()
+	}
+	if !plan.YAxisUnit.IsNull() {
+		if condition.CompositeQuery == nil {
+			condition.CompositeQuery = &model.CompositeQuery{}
+		}
+		condition.CompositeQuery.Unit = plan.YAxisUnit.ValueStringPointer()
+	}
+}
+
+// readConditionValues sets the attributes managing condition values from the
+// condition read from SigNoz. Unset attributes are left null, and the absent
+// data values omitted by SigNoz are read as their defaults.
+func readConditionValues(state *alertResourceModel, alert *model.Alert) error {
+	condition := alert.Condition
+	if condition == nil {
+		condition = &model.Condition{}
+	}
+	stringValue := func(value *string, names map[string]string) types.String {
+		if value == nil {
+			return types.StringNull()
+		}
+		if names != nil {
+			return types.StringValue(model.ConditionValueName(names, *value))
+		}
+		return types.StringPointerValue(value)
+	}
+
+	var err error
+	if state.ClickHouseQueries != nil {
+		if state.ClickHouseQueries, err = queriesToTerraform(alert, model.ConditionKeyCHQueries); err != nil {
+			return err
+		}
+	}
+	if state.PromQLQueries != nil {
+		if state.PromQLQueries, err = queriesToTerraform(alert, model.ConditionKeyPromQueries); err != nil {
+			return err
+		}
+	}
+	if !state.AbsentFor.IsNull() {
+		state.AbsentFor = types.Int64Value(0)
+		if condition.AbsentFor != nil {
+			state.AbsentFor = types.Int64Value(*condition.AbsentFor)
+		}
 	}
-	
-	tflog.Debug(context.Background(), "areJSONsSemanticallyEqual: Successfully unmarshaled both JSONs")
-	
-	// Normalize both by removing default fields
-	normalized1 := removeDefaultFields(data1)
-	normalized2 := removeDefaultFields(data2)
-	
-	tflog.Debug(context.Background(), "areJSONsSemanticallyEqual: Successfully normalized both JSONs")
-	
-	// Marshal back to JSON for comparison
-	bytes1, err := json.Marshal(normalized1)
+	if !state.AlertOnAbsent.IsNull() {
+		state.AlertOnAbsent = types.BoolValue(condition.AlertOnAbsent != nil && *condition.AlertOnAbsent)
+	}
+	if !state.MatchType.IsNull() {
+		state.MatchType = stringValue(condition.MatchType, model.ConditionMatchTypes)
+	}
+	if !state.Op.IsNull() {
+		state.Op = stringValue(condition.Op, model.ConditionOps)
+	}
+	if !state.SelectedQuery.IsNull() {
+		state.SelectedQuery = stringValue(condition.SelectedQueryName, nil)
+	}
+	if !state.Target.IsNull() {
+		state.Target = types.Float64PointerValue(condition.Target)
+	}
+	if !state.TargetUnit.IsNull() {
+		state.TargetUnit = stringValue(condition.TargetUnit, nil)
+	}
+	if !state.YAxisUnit.IsNull() {
+		state.YAxisUnit = types.StringNull()
+		if condition.CompositeQuery != nil {
+			state.YAxisUnit = stringValue(condition.CompositeQuery.Unit, nil)
+		}
+	}
+
+	return nil
+}
+
+// notificationSettingsFromTerraform returns the notification settings of the
+// plan, or nil if they are not set.
+func notificationSettingsFromTerraform(ctx context.Context, tfSettings *alertNotificationSettingsModel, diags *diag.Diagnostics) *model.AlertNotificationSettings {
+	if tfSettings == nil {
+		return nil
+	}
+
+	settings := &model.AlertNotificationSettings{}
+	if !tfSettings.GroupBy.IsNull() {
+		diags.Append(tfSettings.GroupBy.ElementsAs(ctx, &settings.GroupBy, false)...)
+	}
+	if !tfSettings.RepeatInterval.IsNull() {
+		settings.Renotify = &model.AlertRenotify{
+			Enabled:     true,
+			Interval:    tfSettings.RepeatInterval.ValueString(),
+			AlertStates: []string{model.AlertStateFiring},
+		}
+	}
+
+	return settings
+}
+
+// notificationSettingsToTerraform returns the notification settings read from
+// SigNoz, leaving unset values null.
+func notificationSettingsToTerraform(ctx context.Context, settings *model.AlertNotificationSettings) (*alertNotificationSettingsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if settings == nil {
+		return nil, diags
+	}
+
+	tfSettings := &alertNotificationSettingsModel{
+		GroupBy:        types.ListNull(types.StringType),
+		RepeatInterval: customtypes.NewDurationNull(),
+	}
+	if len(settings.GroupBy) > 0 {
+		tfSettings.GroupBy, diags = types.ListValueFrom(ctx, types.StringType, settings.GroupBy)
+	}
+	if settings.Renotify != nil && settings.Renotify.Enabled {
+		tfSettings.RepeatInterval = customtypes.NewDurationValue(settings.Renotify.Interval)
+	}
+
+	return tfSettings, diags
+}
+
+// queriesFromTerraform returns the ClickHouse or PromQL queries of the plan.
+func queriesFromTerraform(tfQueries map[string]alertQueryModel) map[string]model.ConditionQuery {
+	queries := make(map[string]model.ConditionQuery, len(tfQueries))
+	for name, tfQuery := range tfQueries {
+		queries[name] = model.ConditionQuery{
+			Query:    tfQuery.Query.ValueString(),
+			Disabled: tfQuery.Disabled.ValueBool(),
+			Legend:   tfQuery.Legend.ValueString(),
+		}
+	}
+
+	return queries
+}
+
+// queriesToTerraform returns the ClickHouse or PromQL queries of the condition
+// under the key. Missing queries are read as an empty map.
+func queriesToTerraform(alert *model.Alert, key string) (map[string]alertQueryModel, error) {
+	queries, err := alert.ConditionQueries(key)
 	if err != nil {
-		tflog.Debug(context.Background(), "areJSONsSemanticallyEqual: Failed to marshal normalized1", map[string]any{"error": err.Error()})
+		return nil, err
+	}
+
+	tfQueries := make(map[string]alertQueryModel, len(queries))
+	for name, query := range queries {
+		tfQueries[name] = alertQueryModel{
+			Disabled: types.BoolValue(query.Disabled),
+			Legend:   types.StringValue(query.Legend),
+			Query:    types.StringValue(query.Query),
+		}
+	}
+
+	return tfQueries, nil
+}
+
+// setChannels sets the preferred channels and the thresholds of the payload,
+// routing the channels of the severities unless they are set explicitly.
+func setChannels(ctx context.Context, alertPayload *model.Alert, plan alertResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	routes := map[string][]string{}
+	if !plan.SeverityChannels.IsNull() && !plan.SeverityChannels.IsUnknown() {
+		diags.Append(plan.SeverityChannels.ElementsAs(ctx, &routes, false)...)
+	}
+
+	alertPayload.SetPreferredChannels(plan.PreferredChannels.ListValue)
+	if channels, ok := routes[plan.Severity.ValueString()]; ok && (plan.PreferredChannels.IsNull() || plan.PreferredChannels.IsUnknown()) {
+		alertPayload.PreferredChannels = channels
+	}
+
+	thresholds, d := thresholdsFromTerraform(ctx, plan.Thresholds, routes)
+	diags.Append(d...)
+	alertPayload.SetThresholds(thresholds)
+
+	return diags
+}
+
+// resolveChannels stores the channels sent to SigNoz in the unknown channels
+// of the plan.
+func resolveChannels(ctx context.Context, plan *alertResourceModel, alertPayload *model.Alert) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	if plan.PreferredChannels.IsUnknown() {
+		var preferredChannels types.List
+		preferredChannels, diags = alertPayload.PreferredChannelsToTerraform()
+		plan.PreferredChannels = customtypes.NewUnorderedList(preferredChannels)
+	}
+
+	thresholds, err := alertPayload.Thresholds()
+	if err != nil || len(thresholds) != len(plan.Thresholds) {
+		return diags
+	}
+	for i := range plan.Thresholds {
+		if !plan.Thresholds[i].Channels.IsUnknown() {
+			continue
+		}
+		plan.Thresholds[i].Channels = types.ListNull(types.StringType)
+		if len(thresholds[i].Channels) > 0 {
+			plan.Thresholds[i].Channels, d = types.ListValueFrom(ctx, types.StringType, thresholds[i].Channels)
+			diags.Append(d...)
+		}
+	}
+
+	return diags
+}
+
+// thresholdsFromTerraform returns the thresholds of the plan with their API
+// values. Thresholds without channels get the channels routed to their name.
+func thresholdsFromTerraform(ctx context.Context, tfThresholds []alertThresholdModel, routes map[string][]string) ([]model.AlertThreshold, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	thresholds := make([]model.AlertThreshold, 0, len(tfThresholds))
+	for _, tfThreshold := range tfThresholds {
+		threshold := model.AlertThreshold{
+			Name:       tfThreshold.Name.ValueString(),
+			Target:     tfThreshold.Target.ValueFloat64(),
+			TargetUnit: tfThreshold.TargetUnit.ValueString(),
+			MatchType:  model.ConditionMatchTypes[tfThreshold.MatchType.ValueString()],
+			Op:         model.ConditionOps[tfThreshold.Op.ValueString()],
+			Channels:   []string{},
+		}
+		if !tfThreshold.RecoveryTarget.IsNull() {
+			threshold.RecoveryTarget = tfThreshold.RecoveryTarget.ValueFloat64Pointer()
+		}
+		if tfThreshold.Channels.IsNull() || tfThreshold.Channels.IsUnknown() {
+			threshold.Channels = append(threshold.Channels, routes[threshold.Name]...)
+		} else {
+			diags.Append(tfThreshold.Channels.ElementsAs(ctx, &threshold.Channels, false)...)
+		}
+		thresholds = append(thresholds, threshold)
+	}
+
+	return thresholds, diags
+}
+
+// thresholdsToTerraform returns the thresholds read from SigNoz, leaving unset
+// values null.
+func thresholdsToTerraform(ctx context.Context, thresholds []model.AlertThreshold) ([]alertThresholdModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfThresholds := make([]alertThresholdModel, 0, len(thresholds))
+	for _, threshold := range thresholds {
+		tfThreshold := alertThresholdModel{
+			Channels:       types.ListNull(types.StringType),
+			MatchType:      types.StringValue(model.ConditionValueName(model.ConditionMatchTypes, threshold.MatchType)),
+			Name:           types.StringValue(threshold.Name),
+			Op:             types.StringValue(model.ConditionValueName(model.ConditionOps, threshold.Op)),
+			RecoveryTarget: types.Float64PointerValue(threshold.RecoveryTarget),
+			Target:         types.Float64Value(threshold.Target),
+			TargetUnit:     types.StringNull(),
+		}
+		if threshold.TargetUnit != "" {
+			tfThreshold.TargetUnit = types.StringValue(threshold.TargetUnit)
+		}
+		if len(threshold.Channels) > 0 {
+			var d diag.Diagnostics
+			tfThreshold.Channels, d = types.ListValueFrom(ctx, types.StringType, threshold.Channels)
+			diags.Append(d...)
+		}
+		tfThresholds = append(tfThresholds, tfThreshold)
+	}
+
+	return tfThresholds, diags
+}
+
+// onlyDisabledChanged returns true if disabled is the only configured value that
+// differs between the plan and the state.
+func onlyDisabledChanged(plan, state alertResourceModel) bool {
+	if plan.Disabled.Equal(state.Disabled) {
 		return false
 	}
-	
-	bytes2, err := json.Marshal(normalized2)
+
+	// Ignore the disabled flag and the server-managed fields.
+	plan.Disabled = state.Disabled
+	plan.ID = state.ID
+	plan.State = state.State
+	plan.CreateAt = state.CreateAt
+	plan.CreateBy = state.CreateBy
+	plan.UpdateAt = state.UpdateAt
+	plan.UpdateBy = state.UpdateBy
+
+	// Unconfigured computed values are unknown in the plan and kept by SigNoz.
+	if plan.BroadcastToAll.IsUnknown() {
+		plan.BroadcastToAll = state.BroadcastToAll
+	}
+	if plan.Labels.IsUnknown() {
+		plan.Labels = state.Labels
+	}
+	if plan.PreferredChannels.IsUnknown() {
+		plan.PreferredChannels = state.PreferredChannels
+	}
+	if plan.RuleType.IsUnknown() {
+		plan.RuleType = state.RuleType
+	}
+	if len(plan.Thresholds) == len(state.Thresholds) {
+		plan.Thresholds = append([]alertThresholdModel(nil), plan.Thresholds...)
+		for i := range plan.Thresholds {
+			if plan.Thresholds[i].Channels.IsUnknown() {
+				plan.Thresholds[i].Channels = state.Thresholds[i].Channels
+			}
+		}
+	}
+
+	return reflect.DeepEqual(plan, state)
+}
+
+// reconcile re-reads the alert after a write and stores the server-managed
+// values in the plan. Configured values are kept to avoid inconsistent results,
+// and a condition stored differently by SigNoz, once converted to the
+// configured version, is reported as a warning.
+func (r *alertResource) reconcile(ctx context.Context, plan *alertResourceModel, version string, ignoredFields, disabledRules []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	alert, err := r.client.GetAlert(ctx, plan.ID.ValueString())
 	if err != nil {
-		tflog.Debug(context.Background(), "areJSONsSemanticallyEqual: Failed to marshal normalized2", map[string]any{"error": err.Error()})
-		return false
+		diags.AddWarning("Unable to read back alert",
+			fmt.Sprintf("The alert was written, but reading it back failed, so its update time and author may be stale: %s", err))
+		return diags
 	}
-	
-	normalized1Str := string(bytes1)
-	normalized2Str := string(bytes2)
-	
-	// Debug: Log the normalized JSONs
-	tflog.Debug(context.Background(), "areJSONsSemanticallyEqual: Comparing normalized JSONs", map[string]any{
-		"normalized1": normalized1Str,
-		"normalized2": normalized2Str,
-		"areEqual":    normalized1Str == normalized2Str,
-	})
-	
-	return normalized1Str == normalized2Str
+
+	plan.UpdateAt = types.StringValue(alert.UpdateAt)
+	plan.UpdateBy = types.StringValue(alert.UpdateBy)
+	plan.State = types.StringValue(alert.State)
+
+	condition := alert.ConditionWithout(managedConditionKeys(*plan)...)
+
+	planned, err := migrateCondition(plan.Condition.ValueString(), version)
+	if err != nil {
+		return diags
+	}
+	comparison, err := normalize.CompareValue(ctx, planned, condition.Map(), alertConditionOptions(r.client, ignoredFields, disabledRules))
+	if err == nil && !comparison.Equal {
+		diags.AddAttributeWarning(path.Root(attr.Condition), "Alert condition stored differently",
+			"SigNoz stored a condition that differs from the configuration. The difference is reported as drift on the next refresh.")
+	}
+
+	return diags
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -659,3 +1758,29 @@ func (r *alertResource) ImportState(ctx context.Context, req resource.ImportStat
 	// Retrieve import ID and save to id attribute.
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// UpgradeState upgrades states written with prior schema versions.
+func (r *alertResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	// Version 0 has the same attributes as version 1, except for the ones added
+	// later, which are decoded as null. Version 1 marks the start of versioning so
+	// that future attribute changes can migrate states automatically.
+	var current resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+	priorSchema := current.Schema
+	priorSchema.Version = 0
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state alertResourceModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
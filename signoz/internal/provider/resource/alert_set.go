@@ -0,0 +1,474 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/customtypes"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/normalize"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &alertSetResource{}
+	_ resource.ResourceWithConfigure   = &alertSetResource{}
+	_ resource.ResourceWithImportState = &alertSetResource{}
+)
+
+// NewAlertSetResource is a helper function to simplify the provider implementation.
+func NewAlertSetResource() resource.Resource {
+	return &alertSetResource{}
+}
+
+// alertSetResource is the resource implementation.
+type alertSetResource struct {
+	client *client.Client
+}
+
+// alertSetResourceModel maps the resource schema data.
+type alertSetResourceModel struct {
+	ID     types.String                  `tfsdk:"id"`
+	Name   types.String                  `tfsdk:"name"`
+	Alerts map[string]alertSetAlertModel `tfsdk:"alerts"`
+}
+
+// alertSetAlertModel maps an alert of the set, named after its key.
+type alertSetAlertModel struct {
+	AlertType         types.String         `tfsdk:"alert_type"`
+	Condition         types.String         `tfsdk:"condition"`
+	Description       types.String         `tfsdk:"description"`
+	Disabled          types.Bool           `tfsdk:"disabled"`
+	EvalWindow        customtypes.Duration `tfsdk:"eval_window"`
+	Frequency         customtypes.Duration `tfsdk:"frequency"`
+	ID                types.String         `tfsdk:"id"`
+	Labels            types.Map            `tfsdk:"labels"`
+	PreferredChannels types.List           `tfsdk:"preferred_channels"`
+	RuleType          types.String         `tfsdk:"rule_type"`
+	Severity          types.String         `tfsdk:"severity"`
+	Summary           types.String         `tfsdk:"summary"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *alertSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected resource configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			operationConfigure, SigNozAlertSet,
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *alertSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = SigNozAlertSet
+}
+
+// Schema defines the schema for the resource.
+func (r *alertSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a group of alerts in SigNoz as a single resource, e.g. the alerts generated per tenant by a " +
+			"platform. The alerts are tagged with the " + model.AlertSetLabel + " label holding the name of the set, and " +
+			"are refreshed with a single list request. Applying creates, updates and deletes only the alerts which " +
+			"changed, in parallel. Alerts holding the label of the set but missing from the configuration are deleted.",
+		Attributes: map[string]schema.Attribute{
+			attr.Name: schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the set, unique across the sets of the SigNoz instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			attr.Alerts: schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Alerts of the set by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.AlertType: schema.StringAttribute{
+							Required: true,
+							Description: fmt.Sprintf("Type of the alert. Possible values are: %s, %s, %s, and %s.",
+								model.AlertTypeMetrics, model.AlertTypeLogs, model.AlertTypeTraces, model.AlertTypeExceptions),
+							Validators: []validator.String{
+								stringvalidator.OneOf(model.AlertTypes...),
+							},
+						},
+						attr.Condition: schema.StringAttribute{
+							Required: true,
+							Description: "Condition of the alert. It is compared with SigNoz after normalization, as the " +
+								"condition of signoz_alert.",
+						},
+						attr.Description: schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Description of the alert.",
+							Default:     stringdefault.StaticString(alertDefaultDescription),
+						},
+						attr.Disabled: schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether the alert is disabled.",
+							Default:     booldefault.StaticBool(false),
+						},
+						attr.EvalWindow: schema.StringAttribute{
+							CustomType:  customtypes.DurationType{},
+							Optional:    true,
+							Computed:    true,
+							Description: "The evaluation window of the alert. By default, it is " + alertDefaultEvalWindow + ".",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+h)?([0-9]+m)?([0-9]+s)?$`), "invalid alert evaluation window. It should be in format of 5m0s or 15m30s"),
+							},
+							Default: stringdefault.StaticString(alertDefaultEvalWindow),
+						},
+						attr.Frequency: schema.StringAttribute{
+							CustomType:  customtypes.DurationType{},
+							Optional:    true,
+							Computed:    true,
+							Description: "The frequency of the alert. By default, it is " + alertDefaultFrequency + ".",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+h)?([0-9]+m)?([0-9]+s)?$`), "invalid alert frequency. It should be in format of 1m0s or 10m30s"),
+							},
+							Default: stringdefault.StaticString(alertDefaultFrequency),
+						},
+						attr.Labels: schema.MapAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Labels of the alert. The severity and " + model.AlertSetLabel + " labels are set by the provider.",
+						},
+						attr.PreferredChannels: schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Preferred channels of the alert.",
+						},
+						attr.RuleType: schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Description: fmt.Sprintf("Type of the rule. Possible values are: %s and %s. By default, it is %s.",
+								model.AlertRuleTypeThreshold, model.AlertRuleTypeProm, model.AlertRuleTypeThreshold),
+							Validators: []validator.String{
+								stringvalidator.OneOf(model.AlertRuleTypes...),
+							},
+							Default: stringdefault.StaticString(model.AlertRuleTypeThreshold),
+						},
+						attr.Severity: schema.StringAttribute{
+							Required: true,
+							Description: fmt.Sprintf("Severity of the alert. Possible values are: %s, %s, %s, and %s.",
+								model.AlertSeverityInfo, model.AlertSeverityWarning, model.AlertSeverityError, model.AlertSeverityCritical),
+							Validators: []validator.String{
+								stringvalidator.OneOf(model.AlertSeverities...),
+							},
+						},
+						attr.Summary: schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Summary of the alert.",
+							Default:     stringdefault.StaticString(alertDefaultSummary),
+						},
+						// computed.
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "Autogenerated unique ID for the alert.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			// computed.
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the set, i.e. its name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the alerts of the set, adopting the ones already labeled
+// with its name, and sets the initial Terraform state.
+func (r *alertSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Name
+	plan.Alerts = r.apply(ctx, plan.Name.ValueString(), plan.Alerts, nil, operationCreate, &resp.Diagnostics)
+
+	// The state is set even on failure, so that the created alerts are tracked.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the alerts of the set, listed at
+// once.
+func (r *alertSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alerts, err := r.client.ListAlertSet(ctx, state.ID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozAlertSet)
+		return
+	}
+
+	// Alerts missing from SigNoz are dropped, so that they are planned for
+	// creation, while labeled alerts missing from the state are added, so
+	// that they are planned for deletion.
+	refreshed := make(map[string]alertSetAlertModel, len(alerts))
+	for name, alert := range alerts {
+		refreshed[name] = r.toTerraform(ctx, &alert, state.Alerts[name], &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Name = state.ID
+	state.Alerts = refreshed
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update writes the changed alerts of the set and sets the updated Terraform
+// state.
+func (r *alertSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state alertSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Alerts = r.apply(ctx, plan.Name.ValueString(), plan.Alerts, state.Alerts, operationUpdate, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes every alert labeled with the name of the set.
+func (r *alertSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alerts, err := r.client.ListAlertSet(ctx, state.ID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationDelete, SigNozAlertSet)
+		return
+	}
+
+	alertIDs := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		alertIDs = append(alertIDs, alert.ID)
+	}
+
+	tflog.Debug(ctx, "Deleting alert set", map[string]any{"set": state.ID.ValueString(), "count": len(alertIDs)})
+
+	err = r.client.DeleteAlerts(ctx, alertIDs)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationDelete, SigNozAlertSet)
+		return
+	}
+}
+
+// ImportState imports Terraform state into the resource using the name of the set.
+func (r *alertSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.Name), req.ID)...)
+}
+
+// apply creates and updates the planned alerts which differ from the state,
+// and deletes the labeled alerts which are not planned, after listing the set
+// once. It returns the planned alerts with their IDs, leaving out the ones
+// whose creation failed.
+func (r *alertSetResource) apply(ctx context.Context, setName string, plan, state map[string]alertSetAlertModel,
+	operation string, diags *diag.Diagnostics,
+) map[string]alertSetAlertModel {
+	existing, err := r.client.ListAlertSet(ctx, setName)
+	if err != nil {
+		addErr(diags, err, operation, SigNozAlertSet)
+		return state
+	}
+
+	var payloads []*model.Alert
+	for name, planned := range plan {
+		alert, exists := existing[name]
+		if prior, ok := state[name]; ok && exists && prior.equal(planned) {
+			continue
+		}
+
+		payload, err := planned.toModel(name, setName, r.client.ManagedAlertLabels())
+		if err != nil {
+			diags.AddAttributeError(path.Root(attr.Alerts).AtMapKey(name).AtName(attr.Condition), "Invalid alert condition", err.Error())
+			continue
+		}
+		// Labeled alerts with the name are updated, whether tracked or not.
+		payload.ID = alert.ID
+		payloads = append(payloads, payload)
+	}
+
+	var alertIDs []string
+	for name, alert := range existing {
+		if _, ok := plan[name]; !ok {
+			alertIDs = append(alertIDs, alert.ID)
+		}
+	}
+
+	tflog.Debug(ctx, "Applying alert set", map[string]any{
+		"set":     setName,
+		"upserts": len(payloads),
+		"deletes": len(alertIDs),
+	})
+
+	if err := r.client.UpsertAlerts(ctx, payloads); err != nil {
+		addErr(diags, err, operation, SigNozAlertSet)
+	}
+	if err := r.client.DeleteAlerts(ctx, alertIDs); err != nil {
+		addErr(diags, err, operation, SigNozAlertSet)
+	}
+
+	ids := make(map[string]string, len(existing)+len(payloads))
+	for name, alert := range existing {
+		ids[name] = alert.ID
+	}
+	for _, payload := range payloads {
+		ids[payload.Alert] = payload.ID
+	}
+
+	applied := make(map[string]alertSetAlertModel, len(plan))
+	for name, planned := range plan {
+		if ids[name] == "" {
+			continue
+		}
+		planned.ID = types.StringValue(ids[name])
+		applied[name] = planned
+	}
+
+	return applied
+}
+
+// toTerraform converts the alert to the Terraform data. The condition of the
+// prior data is kept if it is equal to the alert's once normalized.
+func (r *alertSetResource) toTerraform(ctx context.Context, alert *model.Alert, prior alertSetAlertModel, diags *diag.Diagnostics) alertSetAlertModel {
+	m := alertSetAlertModel{
+		AlertType:   types.StringValue(alert.AlertType),
+		Description: types.StringValue(alert.Annotations.Description),
+		Disabled:    types.BoolValue(alert.Disabled),
+		EvalWindow:  customtypes.NewDurationValue(alert.EvalWindow),
+		Frequency:   customtypes.NewDurationValue(alert.Frequency),
+		ID:          types.StringValue(alert.ID),
+		RuleType:    types.StringValue(alert.RuleType),
+		Severity:    types.StringValue(alert.Labels[attr.Severity]),
+		Summary:     types.StringValue(alert.Annotations.Summary),
+	}
+
+	condition, err := alert.ConditionToTerraform()
+	if err != nil {
+		addErr(diags, err, operationRead, SigNozAlertSet)
+		return m
+	}
+	m.Condition = condition
+	if !prior.Condition.IsNull() && alert.Condition != nil {
+		opts := alertConditionOptions(r.client, r.client.IgnoreConditionFields(), nil)
+		comparison, err := normalize.CompareValue(ctx, prior.Condition.ValueString(), alert.Condition.Map(), opts)
+		if err == nil && comparison.Equal {
+			m.Condition = prior.Condition
+		}
+	}
+
+	managedLabels := map[string]string{model.AlertSetLabel: ""}
+	for key, value := range r.client.ManagedAlertLabels() {
+		managedLabels[key] = value
+	}
+	labels, d := alert.LabelsToTerraform(managedLabels, false)
+	diags.Append(d...)
+	// Unset labels and channels stay unset while SigNoz has none.
+	if len(labels.Elements()) > 0 || !prior.Labels.IsNull() {
+		m.Labels = labels
+	} else {
+		m.Labels = types.MapNull(types.StringType)
+	}
+	preferredChannels, d := alert.PreferredChannelsToTerraform()
+	diags.Append(d...)
+	if len(preferredChannels.Elements()) > 0 || !prior.PreferredChannels.IsNull() {
+		m.PreferredChannels = preferredChannels
+	} else {
+		m.PreferredChannels = types.ListNull(types.StringType)
+	}
+
+	return m
+}
+
+// toModel converts the Terraform data to the payload of the alert, labeled
+// with the name of the set.
+func (m alertSetAlertModel) toModel(name, setName string, managedLabels map[string]string) (*model.Alert, error) {
+	alertPayload := &model.Alert{
+		Alert:     name,
+		AlertType: m.AlertType.ValueString(),
+		Annotations: model.AlertAnnotations{
+			Description: m.Description.ValueString(),
+			Summary:     m.Summary.ValueString(),
+		},
+		Disabled:   m.Disabled.ValueBool(),
+		EvalWindow: m.EvalWindow.ValueString(),
+		Frequency:  m.Frequency.ValueString(),
+		RuleType:   m.RuleType.ValueString(),
+	}
+
+	if err := alertPayload.SetCondition(m.Condition); err != nil {
+		return nil, err
+	}
+	alertPayload.SetLabels(m.Labels, m.Severity, managedLabels)
+	alertPayload.Labels[model.AlertSetLabel] = setName
+	alertPayload.SetPreferredChannels(m.PreferredChannels)
+
+	return alertPayload, nil
+}
+
+// equal returns true if both alerts have the same configuration.
+func (m alertSetAlertModel) equal(other alertSetAlertModel) bool {
+	return m.AlertType.Equal(other.AlertType) &&
+		m.Condition.Equal(other.Condition) &&
+		m.Description.Equal(other.Description) &&
+		m.Disabled.Equal(other.Disabled) &&
+		m.EvalWindow.Equal(other.EvalWindow) &&
+		m.Frequency.Equal(other.Frequency) &&
+		m.Labels.Equal(other.Labels) &&
+		m.PreferredChannels.Equal(other.PreferredChannels) &&
+		m.RuleType.Equal(other.RuleType) &&
+		m.Severity.Equal(other.Severity) &&
+		m.Summary.Equal(other.Summary)
+}
@@ -0,0 +1,346 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/customtypes"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &alertSilenceResource{}
+	_ resource.ResourceWithConfigure      = &alertSilenceResource{}
+	_ resource.ResourceWithImportState    = &alertSilenceResource{}
+	_ resource.ResourceWithValidateConfig = &alertSilenceResource{}
+)
+
+// NewAlertSilenceResource is a helper function to simplify the provider implementation.
+func NewAlertSilenceResource() resource.Resource {
+	return &alertSilenceResource{}
+}
+
+// alertSilenceResource is the resource implementation.
+type alertSilenceResource struct {
+	client *client.Client
+}
+
+// alertSilenceResourceModel maps the resource schema data.
+type alertSilenceResourceModel struct {
+	AlertIDs  []types.String       `tfsdk:"alert_ids"`
+	Comment   types.String         `tfsdk:"comment"`
+	Duration  customtypes.Duration `tfsdk:"duration"`
+	EndTime   types.String         `tfsdk:"end_time"`
+	ID        types.String         `tfsdk:"id"`
+	Name      types.String         `tfsdk:"name"`
+	StartTime types.String         `tfsdk:"start_time"`
+	Timezone  types.String         `tfsdk:"timezone"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *alertSilenceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected resource configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			operationConfigure, SigNozAlertSilence,
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *alertSilenceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = SigNozAlertSilence
+}
+
+// Schema defines the schema for the resource.
+func (r *alertSilenceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates and manages a time-boxed silence of alerts in SigNoz, stored as a one-off planned downtime. " +
+			"SigNoz selects the silenced alerts by ID rather than by label matchers.",
+		Attributes: map[string]schema.Attribute{
+			attr.AlertIDs: schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the silenced alerts, such as the id of signoz_alert resources.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			attr.Comment: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Why the alerts are silenced. By default, it is empty.",
+				Default:     stringdefault.StaticString(""),
+			},
+			attr.Duration: schema.StringAttribute{
+				CustomType: customtypes.DurationType{},
+				Optional:   true,
+				Description: "How long the alerts are silenced from the start time, e.g. 2h. Equivalent durations such as " +
+					"2h and 2h0m0s are considered equal. Exactly one of duration and end_time must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(attr.EndTime)),
+				},
+			},
+			attr.EndTime: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "End of the silence in RFC 3339 format, e.g. 2024-01-01T04:00:00Z. Set to the start time " +
+					"plus the duration otherwise.",
+			},
+			attr.Name: schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the silence.",
+			},
+			attr.StartTime: schema.StringAttribute{
+				Required:    true,
+				Description: "Start of the silence in RFC 3339 format, e.g. 2024-01-01T02:00:00Z.",
+			},
+			attr.Timezone: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("IANA time zone of the silence, e.g. Europe/Berlin. By default, it is %s.", silenceDefaultTimezone),
+				Default:     stringdefault.StaticString(silenceDefaultTimezone),
+			},
+
+			// computed.
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: "Autogenerated unique ID for the silence.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the times, duration and time zone of the silence.
+func (r *alertSilenceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config alertSilenceResourceModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Duration), &config.Duration)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.EndTime), &config.EndTime)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.StartTime), &config.StartTime)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Timezone), &config.Timezone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, value := range map[string]types.String{attr.StartTime: config.StartTime, attr.EndTime: config.EndTime} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid time",
+				fmt.Sprintf("%q is not in RFC 3339 format, e.g. 2024-01-01T02:00:00Z.", value.ValueString()))
+		}
+	}
+	if !config.Duration.IsNull() && !config.Duration.IsUnknown() {
+		if duration, err := time.ParseDuration(config.Duration.ValueString()); err != nil || duration <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.Duration), "Invalid duration",
+				fmt.Sprintf("%q is not a positive duration, e.g. 2h.", config.Duration.ValueString()))
+		}
+	}
+	if !config.Timezone.IsNull() && !config.Timezone.IsUnknown() {
+		if _, err := time.LoadLocation(config.Timezone.ValueString()); err != nil || config.Timezone.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.Timezone), "Invalid time zone",
+				fmt.Sprintf("%q is not an IANA time zone.", config.Timezone.ValueString()))
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *alertSilenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertSilenceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	downtimePayload, err := plan.toModel()
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozAlertSilence)
+		return
+	}
+
+	tflog.Debug(ctx, "Creating silence", map[string]any{"silence": downtimePayload})
+
+	downtime, err := r.client.CreateDowntime(ctx, downtimePayload)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozAlertSilence)
+		return
+	}
+
+	plan.ID = types.StringValue(string(downtime.ID))
+	plan.EndTime = types.StringValue(downtimePayload.Schedule.EndTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *alertSilenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertSilenceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	downtime, err := r.client.GetDowntime(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		tflog.Warn(ctx, "Silence not found, removing it from state", map[string]any{"silenceID": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozAlertSilence)
+		return
+	}
+
+	state.AlertIDs = make([]types.String, 0, len(downtime.AlertIDs))
+	for _, alertID := range downtime.AlertIDs {
+		state.AlertIDs = append(state.AlertIDs, types.StringValue(alertID))
+	}
+	state.Comment = types.StringValue(downtime.Description)
+	state.Name = types.StringValue(downtime.Name)
+	if downtime.Schedule != nil {
+		state.Timezone = types.StringValue(downtime.Schedule.Timezone)
+		state.StartTime = refreshSilenceTime(state.StartTime, downtime.Schedule.StartTime)
+		state.EndTime = refreshSilenceTime(state.EndTime, downtime.Schedule.EndTime)
+	}
+
+	// Keep the configured duration unless the silence was moved.
+	if !state.Duration.IsNull() {
+		start, startErr := time.Parse(time.RFC3339, state.StartTime.ValueString())
+		end, endErr := time.Parse(time.RFC3339, state.EndTime.ValueString())
+		duration, err := time.ParseDuration(state.Duration.ValueString())
+		if startErr == nil && endErr == nil && (err != nil || end.Sub(start) != duration) {
+			state.Duration = customtypes.NewDurationValue(end.Sub(start).String())
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// refreshSilenceTime returns the time read from SigNoz, unless it is the same
+// instant as the current time, which may be written with another offset.
+func refreshSilenceTime(current types.String, refreshed string) types.String {
+	currentTime, err := time.Parse(time.RFC3339, current.ValueString())
+	if err == nil {
+		if refreshedTime, err := time.Parse(time.RFC3339, refreshed); err == nil && currentTime.Equal(refreshedTime) {
+			return current
+		}
+	}
+
+	return types.StringValue(refreshed)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *alertSilenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state alertSilenceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	downtimePayload, err := plan.toModel()
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, SigNozAlertSilence)
+		return
+	}
+	downtimePayload.ID = model.ObjectID(state.ID.ValueString())
+
+	err = r.client.UpdateDowntime(ctx, state.ID.ValueString(), downtimePayload)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, SigNozAlertSilence)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.EndTime = types.StringValue(downtimePayload.Schedule.EndTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *alertSilenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertSilenceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDowntime(ctx, state.ID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationDelete, SigNozAlertSilence)
+		return
+	}
+}
+
+// ImportState imports Terraform state into the resource.
+func (r *alertSilenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+}
+
+// toModel converts the resource data to the API model, computing the end time
+// from the duration if set.
+func (m alertSilenceResourceModel) toModel() (*model.PlannedDowntime, error) {
+	endTime := m.EndTime.ValueString()
+	if !m.Duration.IsNull() {
+		start, err := time.Parse(time.RFC3339, m.StartTime.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		duration, err := time.ParseDuration(m.Duration.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		endTime = start.Add(duration).Format(time.RFC3339)
+	}
+
+	alertIDs := make([]string, 0, len(m.AlertIDs))
+	for _, alertID := range m.AlertIDs {
+		alertIDs = append(alertIDs, alertID.ValueString())
+	}
+
+	return &model.PlannedDowntime{
+		Name:        m.Name.ValueString(),
+		Description: m.Comment.ValueString(),
+		Schedule: &model.DowntimeSchedule{
+			Timezone:  m.Timezone.ValueString(),
+			StartTime: m.StartTime.ValueString(),
+			EndTime:   endTime,
+		},
+		AlertIDs: alertIDs,
+	}, nil
+}
@@ -1,8 +1,21 @@
 package resource
 
+import "time"
+
 const (
-	SigNozAlert     = "signoz_alert"
-	SigNozDashboard = "signoz_dashboard"
+	SigNozAlert             = "signoz_alert"
+	SigNozAlertSet          = "signoz_alert_set"
+	SigNozAlertSilence      = "signoz_alert_silence"
+	SigNozDashboard         = "signoz_dashboard"
+	SigNozLicense           = "signoz_license"
+	SigNozLogsField         = "signoz_logs_field"
+	SigNozLogsPipeline      = "signoz_logs_pipeline"
+	SigNozLogsPipelineOrder = "signoz_logs_pipeline_order"
+	SigNozMetricMetadata    = "signoz_metric_metadata"
+	SigNozReceiverRaw       = "signoz_receiver_raw"
+	SigNozSSODomain         = "signoz_sso_domain"
+	SigNozTracesField       = "signoz_traces_field"
+	SigNozUserRole          = "signoz_user_role"
 
 	operationCreate = "create"
 	operationRead   = "read"
@@ -14,7 +27,14 @@ const (
 	alertDefaultEvalWindow   = "5m0s"
 	alertDefaultDescription  = "This alert is fired when the defined metric (current value: {{$value}}) crosses the threshold ({{$threshold}})"
 	alertDefaultFrequency    = "1m0s"
+	alertDefaultMatchType    = "at_least_once"
 	alertDefaultSummary      = "The rule threshold is set to {{$threshold}}, and the observed metric value is {{$value}}"
 	alertDefaultSourceSuffix = "alerts"
-	alertDefaultVersion      = "v4"
+
+	silenceDefaultTimezone = "UTC"
+)
+
+const (
+	pipelineDeployTimeout  = 10 * time.Minute
+	pipelineDeployInterval = 5 * time.Second
 )
@@ -2,28 +2,56 @@ package resource
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
 
 	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
 	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/customtypes"
 	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/normalize"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &dashboardResource{}
-	_ resource.ResourceWithConfigure   = &dashboardResource{}
-	_ resource.ResourceWithImportState = &dashboardResource{}
+	_ resource.Resource                   = &dashboardResource{}
+	_ resource.ResourceWithConfigure      = &dashboardResource{}
+	_ resource.ResourceWithImportState    = &dashboardResource{}
+	_ resource.ResourceWithModifyPlan     = &dashboardResource{}
+	_ resource.ResourceWithUpgradeState   = &dashboardResource{}
+	_ resource.ResourceWithValidateConfig = &dashboardResource{}
 )
 
+// privateKeyETag - Private state key of the ETag returned by the last read.
+const privateKeyETag = "etag"
+
+// privateState - Private state of a resource, as exposed by the framework
+// requests and responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
 // NewDashboardResource is a helper function to simplify the provider implementation.
 func NewDashboardResource() resource.Resource {
 	return &dashboardResource{}
@@ -36,23 +64,40 @@ type dashboardResource struct {
 
 // dashboardResourceModel maps the resource schema data.
 type dashboardResourceModel struct {
-	CollapsableRowsMigrated types.Bool   `tfsdk:"collapsable_rows_migrated"`
-	CreatedAt               types.String `tfsdk:"created_at"`
-	CreatedBy               types.String `tfsdk:"created_by"`
-	Description             types.String `tfsdk:"description"`
-	ID                      types.String `tfsdk:"id"`
-	Layout                  types.String `tfsdk:"layout"`
-	Name                    types.String `tfsdk:"name"`
-	PanelMap                types.String `tfsdk:"panel_map"`
-	Source                  types.String `tfsdk:"source"`
-	Tags                    types.List   `tfsdk:"tags"`
-	Title                   types.String `tfsdk:"title"`
-	UpdatedAt               types.String `tfsdk:"updated_at"`
-	UpdatedBy               types.String `tfsdk:"updated_by"`
-	UploadedGrafana         types.Bool   `tfsdk:"uploaded_grafana"`
-	Variables               types.String `tfsdk:"variables"`
-	Version                 types.String `tfsdk:"version"`
-	Widgets                 types.String `tfsdk:"widgets"`
+	AdoptExisting           types.Bool                `tfsdk:"adopt_existing"`
+	CollapsableRowsMigrated types.Bool                `tfsdk:"collapsable_rows_migrated"`
+	CreatedAt               types.String              `tfsdk:"created_at"`
+	CreatedBy               types.String              `tfsdk:"created_by"`
+	Description             types.String              `tfsdk:"description"`
+	DriftDetection          types.String              `tfsdk:"drift_detection"`
+	ID                      types.String              `tfsdk:"id"`
+	Layout                  types.String              `tfsdk:"layout"`
+	LayoutFile              types.String              `tfsdk:"layout_file"`
+	LayoutFileHash          types.String              `tfsdk:"layout_file_hash"`
+	Name                    types.String              `tfsdk:"name"`
+	NumericID               types.String              `tfsdk:"numeric_id"`
+	PanelMap                types.String              `tfsdk:"panel_map"`
+	Rows                    []dashboardRowModel       `tfsdk:"rows"`
+	SkipDefaultTags         types.Bool                `tfsdk:"skip_default_tags"`
+	Source                  types.String              `tfsdk:"source"`
+	Tags                    customtypes.UnorderedList `tfsdk:"tags"`
+	Title                   types.String              `tfsdk:"title"`
+	UpdatedAt               types.String              `tfsdk:"updated_at"`
+	UpdatedBy               types.String              `tfsdk:"updated_by"`
+	UploadedGrafana         types.Bool                `tfsdk:"uploaded_grafana"`
+	UUID                    types.String              `tfsdk:"uuid"`
+	Variables               types.String              `tfsdk:"variables"`
+	Version                 types.String              `tfsdk:"version"`
+	Widgets                 types.String              `tfsdk:"widgets"`
+	WidgetsFile             types.String              `tfsdk:"widgets_file"`
+	WidgetsFileHash         types.String              `tfsdk:"widgets_file_hash"`
+}
+
+// dashboardRowModel maps a collapsible row of the dashboard.
+type dashboardRowModel struct {
+	Collapsed types.Bool                `tfsdk:"collapsed"`
+	ID        types.String              `tfsdk:"id"`
+	Widgets   customtypes.UnorderedList `tfsdk:"widgets"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -84,31 +129,94 @@ func (r *dashboardResource) Metadata(_ context.Context, req resource.MetadataReq
 // Schema defines the schema for the resource.
 func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Creates and manages dashboard resources in SigNoz.",
 		Attributes: map[string]schema.Attribute{
+			attr.AdoptExisting: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Whether to adopt an existing dashboard with the same title on create, updating it with the " +
+					"configuration, instead of creating a duplicate. Creating fails if several dashboards have the title. " +
+					"By default, it is false.",
+				Default: booldefault.StaticBool(false),
+			},
 			attr.CollapsableRowsMigrated: schema.BoolAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
+				Description: "Whether the rows of the dashboard are collapsible. By default, it is true if rows are set, " +
+					"and false otherwise.",
 			},
 			attr.Description: schema.StringAttribute{
 				Required:    true,
 				Description: "Description of the dashboard.",
 			},
+			attr.DriftDetection: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: fmt.Sprintf("How changes made outside of Terraform to the layout, panel map, variables, and widgets "+
+					"are detected. %s refreshes them from SigNoz as is, %s refreshes them only when they differ semantically "+
+					"from the state, and %s keeps the state, except on import. By default, it is %s.",
+					model.DashboardDriftDetectionStrict, model.DashboardDriftDetectionSemantic,
+					model.DashboardDriftDetectionIgnore, model.DashboardDriftDetectionIgnore),
+				Default: stringdefault.StaticString(model.DashboardDriftDetectionIgnore),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.DashboardDriftDetections...),
+				},
+			},
 			attr.Layout: schema.StringAttribute{
-				Required:    true,
-				Description: "Layout of the dashboard.",
+				Optional:    true,
+				Computed:    true,
+				Description: "Layout of the dashboard. Exactly one of layout and layout_file must be set.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			attr.LayoutFile: schema.StringAttribute{
+				Optional: true,
+				Description: "Path of a JSON file holding the layout of the dashboard. The file is validated when planning, " +
+					"and only its hash is stored in the state.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(attr.Layout)),
+				},
+			},
 			attr.Name: schema.StringAttribute{
 				Required:    true,
 				Description: "Name of the dashboard.",
 			},
 			attr.PanelMap: schema.StringAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "Raw panel map of the dashboard, grouping the widgets into rows. Conflicts with rows.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(attr.Rows)),
+				},
+			},
+			attr.Rows: schema.ListNestedAttribute{
+				Optional: true,
+				Description: "Collapsible rows of the dashboard, from which the panel map is generated. Each row is a widget " +
+					"of the dashboard grouping the widgets listed by ID. The widgets of collapsed rows are kept in the layout.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.Collapsed: schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether the row is collapsed. By default, it is false.",
+							Default:     booldefault.StaticBool(false),
+						},
+						attr.ID: schema.StringAttribute{
+							Required:    true,
+							Description: "ID of the row widget.",
+						},
+						attr.Widgets: schema.ListAttribute{
+							CustomType:  customtypes.NewUnorderedListType(types.StringType),
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "IDs of the widgets of the row, in any order.",
+						},
+					},
+				},
 			},
 			attr.Source: schema.StringAttribute{
 				Optional:    true,
@@ -118,10 +226,18 @@ func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			attr.SkipDefaultTags: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to leave out the default_dashboard_tags of the provider from the tags of the dashboard.",
+			},
 			attr.Tags: schema.ListAttribute{
+				CustomType:  customtypes.NewUnorderedListType(types.StringType),
 				Optional:    true,
 				ElementType: types.StringType,
-				Description: "Tags of the dashboard.",
+				Description: "Tags of the dashboard, in any order. The default_dashboard_tags of the provider are added to them, " +
+					"unless skip_default_tags is set.",
 			},
 			attr.Title: schema.StringAttribute{
 				Required:    true,
@@ -138,12 +254,21 @@ func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			attr.Widgets: schema.StringAttribute{
-				Required:    true,
-				Description: "Widgets for the dashboard.",
+				Optional:    true,
+				Computed:    true,
+				Description: "Widgets for the dashboard. Exactly one of widgets and widgets_file must be set.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			attr.WidgetsFile: schema.StringAttribute{
+				Optional: true,
+				Description: "Path of a JSON file holding the widgets for the dashboard. The file is validated when planning, " +
+					"and only its hash is stored in the state.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(attr.Widgets)),
+				},
+			},
 			attr.Version: schema.StringAttribute{
 				Required:    true,
 				Description: "Version of the dashboard.",
@@ -152,7 +277,22 @@ func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			// computed.
 			attr.ID: schema.StringAttribute{
 				Computed:    true,
-				Description: "Autogenerated unique ID for the dashboard.",
+				Description: "Autogenerated unique ID for the dashboard. It is the UUID of the dashboard.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			attr.UUID: schema.StringAttribute{
+				Computed:    true,
+				Description: "UUID addressing the dashboard in the SigNoz APIs.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			attr.NumericID: schema.StringAttribute{
+				Computed: true,
+				Description: "Numeric ID of the dashboard, returned next to its UUID by older SigNoz versions. " +
+					"It is null on versions identifying dashboards by UUID only.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -174,16 +314,20 @@ func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			attr.UpdatedAt: schema.StringAttribute{
 				Computed:    true,
 				Description: "Last update time of the dashboard.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
 			attr.UpdatedBy: schema.StringAttribute{
 				Computed:    true,
 				Description: "Last updater of the dashboard.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+			},
+			attr.LayoutFileHash: schema.StringAttribute{
+				Computed: true,
+				Description: "SHA-256 hash of the layout file. It is refreshed with the hash of the layout stored in SigNoz " +
+					"when it differs from the file according to drift_detection.",
+			},
+			attr.WidgetsFileHash: schema.StringAttribute{
+				Computed: true,
+				Description: "SHA-256 hash of the widgets file. It is refreshed with the hash of the widgets stored in SigNoz " +
+					"when they differ from the file according to drift_detection.",
 			},
 		},
 	}
@@ -208,23 +352,28 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 		Version:                 plan.Version.ValueString(),
 	}
 
-	err := dashboardPayload.SetLayout(plan.Layout)
+	content, err := contentFromFiles(plan)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozDashboard)
+		return
+	}
+	err = dashboardPayload.SetLayout(content.Layout)
 	if err != nil {
 		addErr(&resp.Diagnostics, err, operationCreate, SigNozDashboard)
 		return
 	}
-	err = dashboardPayload.SetPanelMap(plan.PanelMap)
+	err = setPanelMap(dashboardPayload, plan)
 	if err != nil {
 		addErr(&resp.Diagnostics, err, operationCreate, SigNozDashboard)
 		return
 	}
-	dashboardPayload.SetTags(plan.Tags)
+	dashboardPayload.SetTags(plan.Tags.ListValue, r.defaultTags(plan))
 	err = dashboardPayload.SetVariables(plan.Variables)
 	if err != nil {
 		addErr(&resp.Diagnostics, err, operationCreate, SigNozDashboard)
 		return
 	}
-	err = dashboardPayload.SetWidgets(plan.Widgets)
+	err = dashboardPayload.SetWidgets(content.Widgets)
 	if err != nil {
 		addErr(&resp.Diagnostics, err, operationCreate, SigNozDashboard)
 		return
@@ -232,8 +381,12 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 
 	tflog.Debug(ctx, "Creating dashboard", map[string]any{"dashboard": dashboardPayload})
 
-	// Create new dashboard.
-	dashboard, err := r.client.CreateDashboard(ctx, dashboardPayload)
+	// Create new dashboard, or adopt the dashboard with the same title.
+	create := r.client.CreateDashboard
+	if plan.AdoptExisting.ValueBool() {
+		create = r.client.CreateOrAdoptDashboard
+	}
+	dashboard, err := create(ctx, dashboardPayload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating dashboard",
@@ -245,7 +398,9 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 	tflog.Debug(ctx, "Created dashboard", map[string]any{"dashboard": dashboard})
 
 	// Map response to schema and populate Computed attributes.
-	plan.ID = types.StringValue(dashboard.ID)
+	plan.ID = types.StringValue(dashboard.Key())
+	plan.NumericID = dashboardNumericID(dashboard.NumericID())
+	plan.UUID = types.StringValue(dashboard.Key())
 	plan.Source = types.StringValue(dashboard.Data.Source)
 	plan.CreatedAt = types.StringValue(dashboard.CreatedAt)
 	plan.CreatedBy = types.StringValue(dashboard.CreatedBy)
@@ -253,6 +408,9 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 	plan.UpdatedBy = types.StringValue(dashboard.UpdatedBy)
 	plan.Version = types.StringValue(dashboard.Data.Version)
 
+	// Store the values recorded by SigNoz for the creation.
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+
 	// Set state to populated data.
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -271,41 +429,79 @@ func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	tflog.Debug(ctx, "Reading dashboard", map[string]any{"dashboard": state.ID.ValueString()})
 
-	// Get refreshed dashboard from SigNoz.
-	dashboard, err := r.client.GetDashboard(ctx, state.ID.ValueString())
+	// Get refreshed dashboard from SigNoz, unless it did not change since the
+	// last read. The ID may no longer address the dashboard after a SigNoz
+	// migration, so it is then looked up in the list.
+	etag, diags := dashboardETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	dashboard, etag, err := r.client.GetDashboardIfChanged(ctx, state.ID.ValueString(), etag)
+	if errors.Is(err, client.ErrNotModified) {
+		tflog.Debug(ctx, "Dashboard not modified, keeping state", map[string]any{"dashboard": state.ID.ValueString()})
+		return
+	}
+	if errors.Is(err, client.ErrNotFound) {
+		dashboard, err = r.client.FindDashboard(ctx, state.ID.ValueString())
+	}
+	if errors.Is(err, client.ErrNotFound) {
+		tflog.Warn(ctx, "Dashboard not found, removing it from state", map[string]any{"dashboard": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		addErr(&resp.Diagnostics, err, operationRead, SigNozDashboard)
 		return
 	}
 
-	// Preserve original state values for complex JSON fields to avoid drift
-	originalWidgets := state.Widgets
-	originalLayout := state.Layout
-	originalPanelMap := state.PanelMap
-	originalVariables := state.Variables
-
 	// Overwrite items with refreshed state.
 	state.CollapsableRowsMigrated = types.BoolValue(dashboard.Data.CollapsableRowsMigrated)
 	state.CreatedAt = types.StringValue(dashboard.CreatedAt)
 	state.CreatedBy = types.StringValue(dashboard.CreatedBy)
 	state.Description = types.StringValue(dashboard.Data.Description)
-	state.ID = types.StringValue(dashboard.ID)
+	state.ID = types.StringValue(dashboard.Key())
 	state.Name = types.StringValue(dashboard.Data.Name)
+	state.NumericID = dashboardNumericID(dashboard.NumericID())
 	state.Source = types.StringValue(dashboard.Data.Source)
 	state.Title = types.StringValue(dashboard.Data.Title)
 	state.UpdatedAt = types.StringValue(dashboard.UpdatedAt)
 	state.UpdatedBy = types.StringValue(dashboard.UpdatedBy)
 	state.UploadedGrafana = types.BoolValue(dashboard.Data.UploadedGrafana)
+	state.UUID = types.StringValue(dashboard.Key())
 	state.Version = types.StringValue(dashboard.Data.Version)
 
-	// Preserve original complex JSON fields to avoid API reformatting drift
-	state.Widgets = originalWidgets
-	state.Layout = originalLayout
-	state.PanelMap = originalPanelMap
-	state.Variables = originalVariables
+	// Refresh complex JSON fields according to the drift detection mode.
+	err = r.refreshContent(ctx, &state, dashboard.Data)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozDashboard)
+		return
+	}
+
+	// Imported dashboards have no adoption setting yet.
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
 
-	state.Tags, diag = dashboard.Data.TagsToTerraform()
+	// States written before the opt-out existed have no setting yet.
+	if state.SkipDefaultTags.IsNull() {
+		state.SkipDefaultTags = types.BoolValue(false)
+	}
+
+	// The default tags are hidden, unless they are also set on the dashboard.
+	configuredTags := utils.Map(state.Tags.Elements(), func(value tfattr.Value) string {
+		return strings.Trim(value.String(), "\"")
+	})
+	var hiddenTags []string
+	for _, tag := range r.defaultTags(state) {
+		if !slices.Contains(configuredTags, tag) {
+			hiddenTags = append(hiddenTags, tag)
+		}
+	}
+	tags, diag := dashboard.Data.TagsToTerraform(hiddenTags...)
 	resp.Diagnostics.Append(diag...)
+	if !state.Tags.IsNull() || len(tags.Elements()) > 0 {
+		state.Tags = customtypes.NewUnorderedList(tags)
+	}
+
+	resp.Diagnostics.Append(setDashboardETag(ctx, resp.Private, etag)...)
 
 	// Set refreshed state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -314,6 +510,329 @@ func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 }
 
+// defaultTags returns the default tags of the provider added to the dashboard.
+func (r *dashboardResource) defaultTags(dashboard dashboardResourceModel) []string {
+	if dashboard.SkipDefaultTags.ValueBool() {
+		return nil
+	}
+
+	return r.client.DefaultDashboardTags()
+}
+
+// dashboardNumericID returns the numeric ID of the dashboard, or null if SigNoz
+// did not return one.
+func dashboardNumericID(numericID string) types.String {
+	if numericID == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(numericID)
+}
+
+// refreshContent refreshes the layout, panel map, variables, and widgets of the
+// state from the dashboard according to the drift detection mode.
+func (r *dashboardResource) refreshContent(ctx context.Context, state *dashboardResourceModel, dashboard model.Dashboard) error {
+	// Imported dashboards have no drift detection mode yet.
+	if state.DriftDetection.IsNull() {
+		state.DriftDetection = types.StringValue(model.DashboardDriftDetectionIgnore)
+	}
+	mode := state.DriftDetection.ValueString()
+	if mode == model.DashboardDriftDetectionIgnore && (!state.Layout.IsNull() || !state.LayoutFile.IsNull()) &&
+		(!state.PanelMap.IsNull() || state.Rows != nil) && !state.Variables.IsNull() && (!state.Widgets.IsNull() || !state.WidgetsFile.IsNull()) {
+		// Preserve original complex JSON fields to avoid API reformatting drift
+		return nil
+	}
+
+	// The layout of the rows holds the widgets of collapsed rows, which SigNoz
+	// moves to the panel map.
+	if state.Rows != nil {
+		dashboard.ExpandCollapsedRows()
+		if mode != model.DashboardDriftDetectionIgnore {
+			state.Rows = rowsToTerraform(dashboard.Rows(), state.Rows)
+		}
+	}
+
+	layout, err := dashboard.LayoutToTerraform()
+	if err != nil {
+		return err
+	}
+	panelMap, err := dashboard.PanelMapToTerraform()
+	if err != nil {
+		return err
+	}
+	variables, err := dashboard.VariablesToTerraform()
+	if err != nil {
+		return err
+	}
+	widgets, err := dashboard.WidgetsToTerraform()
+	if err != nil {
+		return err
+	}
+
+	cache := r.client.NormalizeCache()
+	if state.Rows == nil {
+		state.PanelMap = refreshDashboardJSON(ctx, cache, mode, state.PanelMap, panelMap)
+	}
+	state.Variables = refreshDashboardJSON(ctx, cache, mode, state.Variables, variables)
+	refreshed := map[string]types.String{attr.LayoutFile: layout, attr.WidgetsFile: widgets}
+	for _, field := range dashboardFileFields(state) {
+		if field.file.IsNull() {
+			*field.content = refreshDashboardJSON(ctx, cache, mode, *field.content, refreshed[field.name])
+		} else {
+			*field.hash = refreshDashboardFile(ctx, cache, mode, *field.file, *field.hash, refreshed[field.name])
+		}
+	}
+
+	return nil
+}
+
+// refreshDashboardJSON returns the refreshed value of a JSON field, unless the
+// mode is semantic and it is semantically equal to the current value, or the
+// mode is ignore. Fields without a current value, such as on import, are
+// always refreshed.
+func refreshDashboardJSON(ctx context.Context, cache *normalize.Cache, mode string, current, refreshed types.String) types.String {
+	if current.IsNull() || refreshed.IsNull() {
+		return refreshed
+	}
+	if mode == model.DashboardDriftDetectionIgnore {
+		return current
+	}
+	if mode != model.DashboardDriftDetectionSemantic {
+		return refreshed
+	}
+
+	comparison, err := normalize.Compare(ctx, current.ValueString(), refreshed.ValueString(),
+		normalize.Options{Subsystem: normalize.SubsystemDashboard, Cache: cache})
+	if err == nil && comparison.Equal {
+		return current
+	}
+
+	return refreshed
+}
+
+// refreshDashboardFile returns the refreshed hash of a JSON field read from a
+// file: the hash of the refreshed value if it differs from the file according
+// to the mode, or the current hash otherwise, such as when the file is gone.
+func refreshDashboardFile(ctx context.Context, cache *normalize.Cache, mode string, file, hash, refreshed types.String) types.String {
+	if mode == model.DashboardDriftDetectionIgnore || refreshed.IsNull() {
+		return hash
+	}
+
+	raw, err := os.ReadFile(file.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read dashboard file, keeping its hash", map[string]any{"file": file.ValueString(), "error": err.Error()})
+		return hash
+	}
+	current := types.StringValue(string(raw))
+	if refreshDashboardJSON(ctx, cache, mode, current, refreshed).Equal(current) {
+		return hash
+	}
+
+	return types.StringValue(contentHash(refreshed.ValueString()))
+}
+
+// setPanelMap sets the panel map of the payload from the rows, if set, or from
+// the raw panel map otherwise. The layout of the payload must be set first.
+func setPanelMap(payload *model.Dashboard, plan dashboardResourceModel) error {
+	if plan.Rows == nil {
+		return payload.SetPanelMap(plan.PanelMap)
+	}
+
+	rows := make([]model.DashboardRow, 0, len(plan.Rows))
+	for _, tfRow := range plan.Rows {
+		rows = append(rows, model.DashboardRow{
+			ID:        tfRow.ID.ValueString(),
+			Collapsed: tfRow.Collapsed.ValueBool(),
+			Widgets: utils.Map(tfRow.Widgets.Elements(), func(value tfattr.Value) string {
+				widget, _ := value.(types.String)
+				return widget.ValueString()
+			}),
+		})
+	}
+	payload.SetRows(rows)
+
+	return nil
+}
+
+// rowsToTerraform returns the rows read from SigNoz, in the order of the
+// current rows, followed by the other rows.
+func rowsToTerraform(rows []model.DashboardRow, current []dashboardRowModel) []dashboardRowModel {
+	order := make(map[string]int, len(current))
+	for i, tfRow := range current {
+		order[tfRow.ID.ValueString()] = i
+	}
+	slices.SortStableFunc(rows, func(a, b model.DashboardRow) int {
+		i, aOK := order[a.ID]
+		j, bOK := order[b.ID]
+		switch {
+		case aOK && bOK:
+			return i - j
+		case aOK:
+			return -1
+		case bOK:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	tfRows := make([]dashboardRowModel, 0, len(rows))
+	for _, row := range rows {
+		widgets := utils.Map(row.Widgets, func(value string) tfattr.Value {
+			return types.StringValue(value)
+		})
+		tfRows = append(tfRows, dashboardRowModel{
+			Collapsed: types.BoolValue(row.Collapsed),
+			ID:        types.StringValue(row.ID),
+			Widgets:   customtypes.NewUnorderedList(types.ListValueMust(types.StringType, widgets)),
+		})
+	}
+
+	return tfRows
+}
+
+// ValidateConfig validates that the rows are collapsible if set.
+func (r *dashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rows types.List
+	var migrated types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Rows), &rows)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.CollapsableRowsMigrated), &migrated)...)
+	if resp.Diagnostics.HasError() || rows.IsNull() || migrated.IsNull() || migrated.IsUnknown() || migrated.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(path.Root(attr.CollapsableRowsMigrated), "Conflicting dashboard rows",
+		fmt.Sprintf("%s must be true when rows are set.", attr.CollapsableRowsMigrated))
+}
+
+// ModifyPlan plans whether the rows are collapsible, and reads the layout and
+// widgets files, if set, to plan their hash instead of their content.
+func (r *dashboardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan dashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Rows are collapsible unless configured otherwise.
+	var migrated types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.CollapsableRowsMigrated), &migrated)...)
+	if migrated.IsNull() {
+		plan.CollapsableRowsMigrated = types.BoolValue(plan.Rows != nil)
+	}
+
+	// List the changed fields of the JSON attributes set inline.
+	if !req.State.Raw.IsNull() {
+		var state dashboardResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		opts := normalize.Options{Subsystem: normalize.SubsystemDashboard}
+		if r.client != nil {
+			opts.Cache = r.client.NormalizeCache()
+		}
+		for _, field := range []struct {
+			name           string
+			prior, planned types.String
+		}{
+			{attr.Layout, state.Layout, plan.Layout},
+			{attr.PanelMap, state.PanelMap, plan.PanelMap},
+			{attr.Variables, state.Variables, plan.Variables},
+			{attr.Widgets, state.Widgets, plan.Widgets},
+		} {
+			if field.prior.IsNull() || field.prior.IsUnknown() || field.planned.IsNull() || field.planned.IsUnknown() {
+				continue
+			}
+			addJSONChangesWarning(ctx, &resp.Diagnostics, path.Root(field.name), field.prior.ValueString(),
+				field.planned.ValueString(), opts)
+		}
+	}
+
+	for _, field := range dashboardFileFields(&plan) {
+		switch {
+		case field.file.IsNull():
+			*field.hash = types.StringNull()
+		case field.file.IsUnknown():
+			*field.content = types.StringNull()
+			*field.hash = types.StringUnknown()
+		default:
+			_, hash, err := readDashboardFile(field.file.ValueString(), field.set)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root(field.name), "Invalid dashboard file", err.Error())
+				continue
+			}
+			*field.content = types.StringNull()
+			*field.hash = types.StringValue(hash)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// dashboardFileField - JSON field of the dashboard which can be read from a
+// file, along with its file and hash attributes.
+type dashboardFileField struct {
+	name                string
+	content, file, hash *types.String
+	set                 func(*model.Dashboard, types.String) error
+}
+
+// dashboardFileFields returns the JSON fields of the model which can be read
+// from a file.
+func dashboardFileFields(m *dashboardResourceModel) []dashboardFileField {
+	return []dashboardFileField{
+		{attr.LayoutFile, &m.Layout, &m.LayoutFile, &m.LayoutFileHash, (*model.Dashboard).SetLayout},
+		{attr.WidgetsFile, &m.Widgets, &m.WidgetsFile, &m.WidgetsFileHash, (*model.Dashboard).SetWidgets},
+	}
+}
+
+// readDashboardFile reads a JSON field from the file and validates it with the
+// setter of the field. It returns the content along with its hash.
+func readDashboardFile(file string, set func(*model.Dashboard, types.String) error) (string, string, error) {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return "", "", err
+	}
+	content := string(raw)
+	if err := set(&model.Dashboard{}, types.StringValue(content)); err != nil {
+		return "", "", fmt.Errorf("%s does not hold valid JSON: %w", file, err)
+	}
+
+	return content, contentHash(content), nil
+}
+
+// contentFromFiles returns a copy of the model with the layout and widgets read
+// from their files, if set. It fails if a file changed since the plan.
+func contentFromFiles(m dashboardResourceModel) (dashboardResourceModel, error) {
+	for _, field := range dashboardFileFields(&m) {
+		if field.file.IsNull() {
+			continue
+		}
+		content, hash, err := readDashboardFile(field.file.ValueString(), field.set)
+		if err != nil {
+			return m, err
+		}
+		if !field.hash.IsUnknown() && field.hash.ValueString() != hash {
+			return m, fmt.Errorf("%s changed since the plan, run terraform apply again", field.file.ValueString())
+		}
+		*field.content = types.StringValue(content)
+	}
+
+	return m, nil
+}
+
+// contentHash returns the hex encoded SHA-256 hash of the content.
+func contentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:])
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Debug(ctx, "Starting dashboard update")
@@ -334,7 +853,6 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 	tflog.Debug(ctx, "Retrieved plan and state successfully")
 
 	// Generate API request body from plan.
-	var err error
 	dashboardUpdate := &model.Dashboard{
 		CollapsableRowsMigrated: plan.CollapsableRowsMigrated.ValueBool(),
 		Description:             plan.Description.ValueString(),
@@ -344,8 +862,14 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		Version:                 plan.Version.ValueString(),
 	}
 
+	content, err := contentFromFiles(plan)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, SigNozDashboard)
+		return
+	}
+
 	tflog.Debug(ctx, "Setting layout")
-	err = dashboardUpdate.SetLayout(plan.Layout)
+	err = dashboardUpdate.SetLayout(content.Layout)
 	if err != nil {
 		tflog.Error(ctx, "Failed to set layout", map[string]any{"error": err.Error()})
 		addErr(&resp.Diagnostics, err, operationUpdate, SigNozDashboard)
@@ -353,7 +877,7 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	tflog.Debug(ctx, "Setting panel map")
-	err = dashboardUpdate.SetPanelMap(plan.PanelMap)
+	err = setPanelMap(dashboardUpdate, plan)
 	if err != nil {
 		tflog.Error(ctx, "Failed to set panel map", map[string]any{"error": err.Error()})
 		addErr(&resp.Diagnostics, err, operationUpdate, SigNozDashboard)
@@ -361,7 +885,7 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	tflog.Debug(ctx, "Setting tags")
-	dashboardUpdate.SetTags(plan.Tags)
+	dashboardUpdate.SetTags(plan.Tags.ListValue, r.defaultTags(plan))
 
 	tflog.Debug(ctx, "Setting variables")
 	err = dashboardUpdate.SetVariables(plan.Variables)
@@ -372,7 +896,7 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	tflog.Debug(ctx, "Setting widgets")
-	err = dashboardUpdate.SetWidgets(plan.Widgets)
+	err = dashboardUpdate.SetWidgets(content.Widgets)
 	if err != nil {
 		tflog.Error(ctx, "Failed to set widgets", map[string]any{"error": err.Error()})
 		addErr(&resp.Diagnostics, err, operationUpdate, SigNozDashboard)
@@ -387,18 +911,20 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	// Instead of fetching fresh state (which causes inconsistencies),
-	// we'll use the plan data and preserve the original server-managed fields from state.
-	// This avoids the "inconsistent result" error while maintaining data integrity.
-
 	// Preserve server-managed fields from current state
 	plan.ID = state.ID
+	plan.NumericID = state.NumericID
+	plan.UUID = state.ID
 	plan.CreatedAt = state.CreatedAt
 	plan.CreatedBy = state.CreatedBy
 	plan.UpdatedAt = state.UpdatedAt
 	plan.UpdatedBy = state.UpdatedBy
 	plan.Source = state.Source
 
+	// Store the values recorded by SigNoz for the update.
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+	resp.Diagnostics.Append(setDashboardETag(ctx, resp.Private, "")...)
+
 	// Set refreshed state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -406,6 +932,82 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 }
 
+// dashboardETag returns the ETag of the last read stored in the private state.
+func dashboardETag(ctx context.Context, private privateState) (string, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, privateKeyETag)
+	if len(raw) == 0 || diags.HasError() {
+		return "", diags
+	}
+
+	var etag string
+	if err := json.Unmarshal(raw, &etag); err != nil {
+		return "", nil
+	}
+
+	return etag, diags
+}
+
+// setDashboardETag stores the ETag of the last read in the private state, or
+// removes it if empty.
+func setDashboardETag(ctx context.Context, private privateState, etag string) diag.Diagnostics {
+	if etag == "" {
+		return private.SetKey(ctx, privateKeyETag, nil)
+	}
+
+	raw, err := json.Marshal(etag)
+	if err != nil {
+		return nil
+	}
+
+	return private.SetKey(ctx, privateKeyETag, raw)
+}
+
+// reconcile re-reads the dashboard after a write and stores the server-managed
+// values in the plan. Configured values are kept to avoid inconsistent results,
+// and content stored differently by SigNoz is reported as a warning.
+func (r *dashboardResource) reconcile(ctx context.Context, plan *dashboardResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	dashboard, err := r.client.GetDashboard(ctx, plan.ID.ValueString())
+	if err != nil {
+		diags.AddWarning("Unable to read back dashboard",
+			fmt.Sprintf("The dashboard was written, but reading it back failed, so its update time and author may be stale: %s", err))
+		return diags
+	}
+
+	plan.NumericID = dashboardNumericID(dashboard.NumericID())
+	plan.UpdatedAt = types.StringValue(dashboard.UpdatedAt)
+	plan.UpdatedBy = types.StringValue(dashboard.UpdatedBy)
+
+	stored := *plan
+	stored.DriftDetection = types.StringValue(model.DashboardDriftDetectionSemantic)
+	err = r.refreshContent(ctx, &stored, dashboard.Data)
+	if err != nil {
+		diags.AddWarning("Unable to read back dashboard content", err.Error())
+		return diags
+	}
+
+	for _, field := range []struct {
+		name              string
+		planned, returned types.String
+	}{
+		{attr.Layout, plan.Layout, stored.Layout},
+		{attr.PanelMap, plan.PanelMap, stored.PanelMap},
+		{attr.Variables, plan.Variables, stored.Variables},
+		{attr.Widgets, plan.Widgets, stored.Widgets},
+		{attr.LayoutFile, plan.LayoutFileHash, stored.LayoutFileHash},
+		{attr.WidgetsFile, plan.WidgetsFileHash, stored.WidgetsFileHash},
+	} {
+		if !field.planned.IsNull() && !field.planned.Equal(field.returned) {
+			diags.AddAttributeWarning(path.Root(field.name), "Dashboard content stored differently",
+				"SigNoz stored a value that differs from the configuration. Depending on drift_detection, "+
+					"the difference is reported as drift on the next refresh.")
+		}
+	}
+
+	return diags
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state.
@@ -425,6 +1027,37 @@ func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState imports Terraform state into the resource.
 func (r *dashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute.
+	// Retrieve import ID and save to id attribute. Both the numeric ID and the
+	// UUID are accepted, as Read resolves the dashboard to its UUID.
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// UpgradeState upgrades states written with prior schema versions.
+func (r *dashboardResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	// Version 0 has the same attributes as version 1, except for the ones added
+	// later, which are decoded as null. Version 1 marks the start of versioning so
+	// that future attribute changes can migrate states automatically.
+	var current resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+	priorSchema := current.Schema
+	priorSchema.Version = 0
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state dashboardResourceModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				if state.DriftDetection.IsNull() {
+					state.DriftDetection = types.StringValue(model.DashboardDriftDetectionIgnore)
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
@@ -1,9 +1,13 @@
 package resource
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/normalize"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // addErr adds an error to the diagnostics.
@@ -17,3 +21,31 @@ func addErr(diagnostics *diag.Diagnostics, err error, operation string, resource
 		err.Error(),
 	)
 }
+
+// jsonChangesMax is the number of changed fields listed at most by a JSON
+// changes warning.
+const jsonChangesMax = 20
+
+// addJSONChangesWarning adds a warning listing the fields of the JSON attribute
+// changed by the plan, as Terraform shows the change of the whole string.
+func addJSONChangesWarning(ctx context.Context, diagnostics *diag.Diagnostics, attrPath path.Path, prior, planned string, opts normalize.Options) {
+	comparison, err := normalize.Compare(ctx, prior, planned, opts)
+	if err != nil || comparison.Equal {
+		return
+	}
+	changes, err := comparison.Diff(attrPath.String())
+	if err != nil || len(changes) == 0 {
+		return
+	}
+
+	lines := make([]string, 0, min(len(changes), jsonChangesMax)+1)
+	for _, change := range changes[:min(len(changes), jsonChangesMax)] {
+		lines = append(lines, "  "+change.String())
+	}
+	if len(changes) > jsonChangesMax {
+		lines = append(lines, fmt.Sprintf("  and %d more", len(changes)-jsonChangesMax))
+	}
+
+	diagnostics.AddAttributeWarning(attrPath, "Planned changes to "+attrPath.String(),
+		fmt.Sprintf("The plan changes these fields of %s:\n%s", attrPath, strings.Join(lines, "\n")))
+}
@@ -0,0 +1,212 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &licenseResource{}
+	_ resource.ResourceWithConfigure = &licenseResource{}
+)
+
+// NewLicenseResource is a helper function to simplify the provider implementation.
+func NewLicenseResource() resource.Resource {
+	return &licenseResource{}
+}
+
+// licenseResource is the resource implementation.
+type licenseResource struct {
+	client *client.Client
+}
+
+// licenseResourceModel maps the resource schema data.
+type licenseResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Key        types.String `tfsdk:"key"`
+	Status     types.String `tfsdk:"status"`
+	Plan       types.String `tfsdk:"plan"`
+	ValidFrom  types.Int64  `tfsdk:"valid_from"`
+	ValidUntil types.Int64  `tfsdk:"valid_until"`
+	Features   types.List   `tfsdk:"features"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *licenseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected resource configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			operationConfigure, SigNozLicense,
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *licenseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = SigNozLicense
+}
+
+// Schema defines the schema for the resource.
+func (r *licenseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Applies an enterprise license key to SigNoz EE deployments. " +
+			"Licenses cannot be removed through the API, so destroying this resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			attr.Key: schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "License key to apply. Changing it applies the new key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// computed.
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the applied license.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			attr.Status: schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the license, e.g. VALID.",
+			},
+			attr.Plan: schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the plan the license belongs to.",
+			},
+			attr.ValidFrom: schema.Int64Attribute{
+				Computed:    true,
+				Description: "Start of the license validity as a unix timestamp.",
+			},
+			attr.ValidUntil: schema.Int64Attribute{
+				Computed:    true,
+				Description: "End of the license validity as a unix timestamp.",
+			},
+			attr.Features: schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Features enabled by the license.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *licenseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan licenseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Applying license")
+
+	_, err := r.client.ApplyLicense(ctx, plan.Key.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozLicense)
+		return
+	}
+
+	// The apply response may omit details, so read the active license back.
+	license, err := r.client.GetActiveLicense(ctx)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozLicense)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setComputed(license)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *licenseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state licenseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	license, err := r.client.GetActiveLicense(ctx)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozLicense)
+		return
+	}
+
+	// Another key was applied outside of Terraform: plan to re-apply ours.
+	if license.Key != "" && license.Key != state.Key.ValueString() {
+		tflog.Warn(ctx, "Active license differs from the managed one, removing it from state", map[string]any{"licenseID": license.ID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.setComputed(license)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called as every configurable attribute requires replacement.
+func (r *licenseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan licenseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the license from the Terraform state. SigNoz has no API to remove licenses.
+func (r *licenseResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Warn(ctx, "SigNoz licenses cannot be removed, the license stays active and is only removed from state")
+	resp.Diagnostics.AddWarning(
+		"License kept in SigNoz",
+		"SigNoz has no API to remove a license. The license stays active and was only removed from the Terraform state.",
+	)
+}
+
+// setComputed copies the computed attributes from the license.
+func (m *licenseResourceModel) setComputed(license *model.License) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(license.ID)
+	m.Status = types.StringValue(license.Status)
+	m.Plan = types.StringValue(license.Plan.Name)
+	m.ValidFrom = types.Int64Value(license.ValidFrom)
+	m.ValidUntil = types.Int64Value(license.ValidUntil)
+	m.Features, diags = license.FeaturesToTerraform()
+
+	return diags
+}
@@ -0,0 +1,878 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/normalize"
+)
+
+//nolint:gochecknoglobals
+var (
+	// pipelineFieldRegex matches the log fields processors read from and write to.
+	pipelineFieldRegex = regexp.MustCompile(`^(body|attributes|resource)(\..+)?$`)
+	// pipelineAttributeRegex matches a single attribute of the log.
+	pipelineAttributeRegex = regexp.MustCompile(`^(attributes|resource)\..+$`)
+	// pipelineParseToRegex matches the targets of parsers.
+	pipelineParseToRegex = regexp.MustCompile(`^(attributes|resource)(\..+)?$`)
+	// pipelineNamedGroupRegex matches a named capture group.
+	pipelineNamedGroupRegex = regexp.MustCompile(`\(\?P?<\w+>`)
+
+	pipelineSeverityLevels = []string{"trace", "debug", "info", "warn", "error", "fatal"}
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &logsPipelineResource{}
+	_ resource.ResourceWithConfigure      = &logsPipelineResource{}
+	_ resource.ResourceWithImportState    = &logsPipelineResource{}
+	_ resource.ResourceWithValidateConfig = &logsPipelineResource{}
+)
+
+// NewLogsPipelineResource is a helper function to simplify the provider implementation.
+func NewLogsPipelineResource() resource.Resource {
+	return &logsPipelineResource{}
+}
+
+// logsPipelineResource is the resource implementation.
+type logsPipelineResource struct {
+	client *client.Client
+}
+
+// logsPipelineResourceModel maps the resource schema data.
+type logsPipelineResourceModel struct {
+	ID            types.String     `tfsdk:"id"`
+	Name          types.String     `tfsdk:"name"`
+	Alias         types.String     `tfsdk:"alias"`
+	Description   types.String     `tfsdk:"description"`
+	Enabled       types.Bool       `tfsdk:"enabled"`
+	WaitForDeploy types.Bool       `tfsdk:"wait_for_deploy"`
+	Filter        types.String     `tfsdk:"filter"`
+	Processors    []processorModel `tfsdk:"processor"`
+}
+
+// processorModel maps a pipeline processor. Exactly one of the typed blocks is set.
+type processorModel struct {
+	Name           types.String          `tfsdk:"name"`
+	Enabled        types.Bool            `tfsdk:"enabled"`
+	GrokParser     *grokParserModel      `tfsdk:"grok_parser"`
+	RegexParser    *regexParserModel     `tfsdk:"regex_parser"`
+	JSONParser     *jsonParserModel      `tfsdk:"json_parser"`
+	TraceParser    *traceParserModel     `tfsdk:"trace_parser"`
+	Add            *addProcessorModel    `tfsdk:"add"`
+	Remove         *removeProcessorModel `tfsdk:"remove"`
+	Move           *moveProcessorModel   `tfsdk:"move"`
+	Copy           *moveProcessorModel   `tfsdk:"copy"`
+	SeverityParser *severityParserModel  `tfsdk:"severity_parser"`
+}
+
+// grokParserModel maps the grok_parser processor.
+type grokParserModel struct {
+	Pattern   types.String `tfsdk:"pattern"`
+	ParseFrom types.String `tfsdk:"parse_from"`
+	ParseTo   types.String `tfsdk:"parse_to"`
+	OnError   types.String `tfsdk:"on_error"`
+}
+
+// regexParserModel maps the regex_parser processor.
+type regexParserModel struct {
+	Regex     types.String `tfsdk:"regex"`
+	ParseFrom types.String `tfsdk:"parse_from"`
+	ParseTo   types.String `tfsdk:"parse_to"`
+	OnError   types.String `tfsdk:"on_error"`
+}
+
+// jsonParserModel maps the json_parser processor.
+type jsonParserModel struct {
+	ParseFrom types.String `tfsdk:"parse_from"`
+	ParseTo   types.String `tfsdk:"parse_to"`
+	OnError   types.String `tfsdk:"on_error"`
+}
+
+// traceParserModel maps the trace_parser processor.
+type traceParserModel struct {
+	TraceID    types.String `tfsdk:"trace_id"`
+	SpanID     types.String `tfsdk:"span_id"`
+	TraceFlags types.String `tfsdk:"trace_flags"`
+}
+
+// addProcessorModel maps the add processor.
+type addProcessorModel struct {
+	Field types.String `tfsdk:"field"`
+	Value types.String `tfsdk:"value"`
+}
+
+// removeProcessorModel maps the remove processor.
+type removeProcessorModel struct {
+	Field types.String `tfsdk:"field"`
+}
+
+// moveProcessorModel maps the move and copy processors.
+type moveProcessorModel struct {
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+}
+
+// severityParserModel maps the severity_parser processor.
+type severityParserModel struct {
+	ParseFrom     types.String `tfsdk:"parse_from"`
+	Mapping       types.Map    `tfsdk:"mapping"`
+	OverwriteText types.Bool   `tfsdk:"overwrite_text"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *logsPipelineResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected resource configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			operationConfigure, SigNozLogsPipeline,
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *logsPipelineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = SigNozLogsPipeline
+}
+
+// Schema defines the schema for the resource.
+func (r *logsPipelineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates and manages a log pipeline in SigNoz. Pipelines are applied in the order they were created, " +
+			"unless ordered by a signoz_logs_pipeline_order resource. Changes of pipelines applied in parallel are " +
+			"saved as a single version, deployed once.",
+		Attributes: map[string]schema.Attribute{
+			attr.Name: schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the pipeline.",
+			},
+			attr.Alias: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Alias of the pipeline. By default, it is the name of the pipeline.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			attr.Description: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Description of the pipeline.",
+				Default:     stringdefault.StaticString(""),
+			},
+			attr.Enabled: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Whether the pipeline is enabled. By default, it is true. When it is the only change, " +
+					"only the flag of the saved pipeline is updated.",
+				Default: booldefault.StaticBool(true),
+			},
+			attr.Filter: schema.StringAttribute{
+				Required: true,
+				Description: "Filter selecting the logs processed by the pipeline, as a query builder filter set in JSON. " +
+					"Its operators and attribute keys are validated at plan time.",
+			},
+			attr.Processor: schema.ListNestedAttribute{
+				Optional: true,
+				Description: "Processors of the pipeline, applied in order. Each processor sets exactly one of " +
+					"grok_parser, regex_parser, json_parser, trace_parser, add, remove, move, copy and severity_parser.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: processorSchemaAttributes(),
+				},
+			},
+			attr.WaitForDeploy: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Description: fmt.Sprintf("Whether to wait until SigNoz reports the saved pipelines as deployed to the "+
+					"collectors, failing if the deployment fails or takes longer than %s. By default, it is false.", pipelineDeployTimeout),
+				Default: booldefault.StaticBool(false),
+			},
+			// computed.
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: "Autogenerated unique ID for the pipeline.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// processorSchemaAttributes returns the attributes of a pipeline processor.
+func processorSchemaAttributes() map[string]schema.Attribute {
+	parseFrom := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: description + " By default, it is body.",
+			Default:     stringdefault.StaticString("body"),
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(pipelineFieldRegex, "must be body or start with attributes. or resource."),
+			},
+		}
+	}
+	parseTo := schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Field the parsed values are written to. By default, it is attributes.",
+		Default:     stringdefault.StaticString("attributes"),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(pipelineParseToRegex, "must be attributes, resource or start with attributes. or resource."),
+		},
+	}
+	onError := schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Description: fmt.Sprintf("What to do with the log when parsing fails. Possible values are: %s and %s. By default, it is %s.",
+			model.PipelineOnErrorSend, model.PipelineOnErrorDrop, model.PipelineOnErrorSend),
+		Default: stringdefault.StaticString(model.PipelineOnErrorSend),
+		Validators: []validator.String{
+			stringvalidator.OneOf(model.PipelineOnErrors...),
+		},
+	}
+	field := func(description string, re *regexp.Regexp, message string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Required:    true,
+			Description: description,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(re, message),
+			},
+		}
+	}
+	traceField := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Description: description,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(pipelineFieldRegex, "must be body or start with attributes. or resource."),
+			},
+		}
+	}
+	moveAttributes := map[string]schema.Attribute{
+		attr.From: field("Field to read the value from.", pipelineFieldRegex, "must be body or start with attributes. or resource."),
+		attr.To:   field("Field to write the value to.", pipelineFieldRegex, "must be body or start with attributes. or resource."),
+	}
+
+	return map[string]schema.Attribute{
+		attr.Name: schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the processor.",
+		},
+		attr.Enabled: schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether the processor is enabled. By default, it is true.",
+			Default:     booldefault.StaticBool(true),
+		},
+		model.PipelineProcessorGrokParser: schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Parses a field with a grok pattern.",
+			Attributes: map[string]schema.Attribute{
+				attr.Pattern: schema.StringAttribute{
+					Required:    true,
+					Description: "Grok pattern.",
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				attr.ParseFrom: parseFrom("Field to parse."),
+				attr.ParseTo:   parseTo,
+				attr.OnError:   onError,
+			},
+		},
+		model.PipelineProcessorRegexParser: schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Parses a field with a regular expression.",
+			Attributes: map[string]schema.Attribute{
+				attr.Regex: schema.StringAttribute{
+					Required:    true,
+					Description: "Regular expression with at least one named capture group.",
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(pipelineNamedGroupRegex, "must contain at least one named capture group"),
+					},
+				},
+				attr.ParseFrom: parseFrom("Field to parse."),
+				attr.ParseTo:   parseTo,
+				attr.OnError:   onError,
+			},
+		},
+		model.PipelineProcessorJSONParser: schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Parses a field containing JSON.",
+			Attributes: map[string]schema.Attribute{
+				attr.ParseFrom: parseFrom("Field to parse."),
+				attr.ParseTo:   parseTo,
+				attr.OnError:   onError,
+			},
+		},
+		model.PipelineProcessorTraceParser: schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Sets the trace context of the log. At least one field must be set.",
+			Attributes: map[string]schema.Attribute{
+				attr.TraceID:    traceField("Field containing the trace ID."),
+				attr.SpanID:     traceField("Field containing the span ID."),
+				attr.TraceFlags: traceField("Field containing the trace flags."),
+			},
+		},
+		model.PipelineProcessorAdd: schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Adds a field to the log.",
+			Attributes: map[string]schema.Attribute{
+				attr.Field: field("Field to add.", pipelineAttributeRegex, "must start with attributes. or resource."),
+				attr.Value: schema.StringAttribute{
+					Required:    true,
+					Description: "Value of the field. It may be an expression in the form EXPR(...).",
+				},
+			},
+		},
+		model.PipelineProcessorRemove: schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Removes a field from the log.",
+			Attributes: map[string]schema.Attribute{
+				attr.Field: field("Field to remove.", pipelineAttributeRegex, "must start with attributes. or resource."),
+			},
+		},
+		model.PipelineProcessorMove: schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Moves a field of the log.",
+			Attributes:  moveAttributes,
+		},
+		model.PipelineProcessorCopy: schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Copies a field of the log.",
+			Attributes:  moveAttributes,
+		},
+		model.PipelineProcessorSeverityParser: schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Sets the severity of the log from a field.",
+			Attributes: map[string]schema.Attribute{
+				attr.ParseFrom: schema.StringAttribute{
+					Required:    true,
+					Description: "Field containing the severity.",
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(pipelineFieldRegex, "must be body or start with attributes. or resource."),
+					},
+				},
+				attr.Mapping: schema.MapAttribute{
+					Optional: true,
+					ElementType: types.ListType{
+						ElemType: types.StringType,
+					},
+					Description: "Values of the field mapped to each severity level. Keys are: trace, debug, info, warn, error and fatal. " +
+						"A value may only be mapped to one level.",
+					Validators: []validator.Map{
+						mapvalidator.KeysAre(stringvalidator.OneOf(pipelineSeverityLevels...)),
+					},
+				},
+				attr.OverwriteText: schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Whether the severity text is overwritten with the mapped level. By default, it is true.",
+					Default:     booldefault.StaticBool(true),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the filter is a valid filter set and every processor
+// sets exactly one type.
+func (r *logsPipelineResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config logsPipelineResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Filter.IsNull() && !config.Filter.IsUnknown() {
+		filter, err := structure.ExpandJsonFromString(config.Filter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.Filter), "Invalid filter", err.Error())
+		} else {
+			for _, filterErr := range model.ValidateFilterSet(filter) {
+				resp.Diagnostics.AddAttributeError(path.Root(attr.Filter), "Invalid filter", filterErr.Error())
+			}
+		}
+	}
+
+	for i, processor := range config.Processors {
+		processorPath := path.Root(attr.Processor).AtListIndex(i)
+
+		processorTypes := processor.types()
+		if len(processorTypes) != 1 {
+			resp.Diagnostics.AddAttributeError(processorPath, "Invalid processor",
+				fmt.Sprintf("Exactly one processor type must be set, got %d: %v.", len(processorTypes), processorTypes))
+			continue
+		}
+
+		if tp := processor.TraceParser; tp != nil && tp.TraceID.IsNull() && tp.SpanID.IsNull() && tp.TraceFlags.IsNull() {
+			resp.Diagnostics.AddAttributeError(processorPath.AtName(model.PipelineProcessorTraceParser), "Invalid trace parser",
+				fmt.Sprintf("At least one of %s, %s and %s must be set.", attr.TraceID, attr.SpanID, attr.TraceFlags))
+		}
+
+		if sp := processor.SeverityParser; sp != nil && !sp.Mapping.IsUnknown() {
+			var mapping map[string]types.List
+			resp.Diagnostics.Append(sp.Mapping.ElementsAs(ctx, &mapping, false)...)
+			mappingPath := processorPath.AtName(model.PipelineProcessorSeverityParser).AtName(attr.Mapping)
+			for _, duplicate := range duplicateSeverityValues(mapping) {
+				resp.Diagnostics.AddAttributeError(mappingPath, "Invalid severity mapping", duplicate)
+			}
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *logsPipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan logsPipelineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pipelinePayload, err := plan.toModel(ctx)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozLogsPipeline)
+		return
+	}
+
+	tflog.Debug(ctx, "Creating pipeline", map[string]any{"pipeline": pipelinePayload.Name})
+
+	created, saved, err := r.client.CreatePipeline(ctx, *pipelinePayload)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozLogsPipeline)
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	plan.Alias = types.StringValue(pipelinePayload.Alias)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	r.waitForDeploy(ctx, plan, saved.Version, operationCreate, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *logsPipelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state logsPipelineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pipeline, err := r.client.GetPipeline(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		tflog.Warn(ctx, "Pipeline not found, removing it from state", map[string]any{"pipelineID": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozLogsPipeline)
+		return
+	}
+
+	filter := state.Filter
+	if state.WaitForDeploy.IsNull() {
+		state.WaitForDeploy = types.BoolValue(false)
+	}
+	err = state.fromModel(ctx, pipeline)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozLogsPipeline)
+		return
+	}
+	// Keep the configured formatting when the filter is unchanged.
+	if !filter.IsNull() && !state.Filter.IsNull() {
+		comparison, err := normalize.Compare(ctx, filter.ValueString(), state.Filter.ValueString(),
+			normalize.Options{Subsystem: normalize.SubsystemPipelineFilter, Cache: r.client.NormalizeCache()})
+		if err == nil && comparison.Equal {
+			state.Filter = filter
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *logsPipelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state logsPipelineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pipelinePayload, err := plan.toModel(ctx)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, SigNozLogsPipeline)
+		return
+	}
+	pipelinePayload.ID = state.ID.ValueString()
+
+	// SigNoz has no API to toggle a single pipeline, so when only enabled
+	// changes, the flag alone is flipped on the saved pipeline, leaving its
+	// filter and processors as they are on the server.
+	toggleOnly := plan.onlyEnabledChanged(state)
+	if toggleOnly {
+		tflog.Debug(ctx, "Toggling pipeline", map[string]any{"pipelineID": pipelinePayload.ID, "enabled": pipelinePayload.Enabled})
+	}
+
+	saved, err := r.client.UpdatePipelines(ctx, func(pipelines []model.Pipeline) ([]model.Pipeline, error) {
+		for i := range pipelines {
+			if pipelines[i].ID != pipelinePayload.ID {
+				continue
+			}
+			if toggleOnly {
+				pipelines[i].Enabled = pipelinePayload.Enabled
+			} else {
+				pipelines[i] = *pipelinePayload
+			}
+			return pipelines, nil
+		}
+		return nil, fmt.Errorf("pipeline %s: %w", pipelinePayload.ID, client.ErrNotFound)
+	})
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, SigNozLogsPipeline)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Alias = types.StringValue(pipelinePayload.Alias)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	r.waitForDeploy(ctx, plan, saved.Version, operationUpdate, &resp.Diagnostics)
+}
+
+// waitForDeploy waits for the saved version of the pipelines to be deployed,
+// if requested. The state is already set, so a failed deployment taints the
+// pipeline.
+func (r *logsPipelineResource) waitForDeploy(ctx context.Context, plan logsPipelineResourceModel, version int, operation string, diags *diag.Diagnostics) {
+	if !plan.WaitForDeploy.ValueBool() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pipelineDeployTimeout)
+	defer cancel()
+	if err := r.client.WaitForPipelinesDeploy(ctx, version, pipelineDeployInterval); err != nil {
+		addErr(diags, err, operation, SigNozLogsPipeline)
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *logsPipelineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state logsPipelineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdatePipelines(ctx, func(pipelines []model.Pipeline) ([]model.Pipeline, error) {
+		remaining := make([]model.Pipeline, 0, len(pipelines))
+		for _, pipeline := range pipelines {
+			if pipeline.ID != state.ID.ValueString() {
+				remaining = append(remaining, pipeline)
+			}
+		}
+		return remaining, nil
+	})
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationDelete, SigNozLogsPipeline)
+		return
+	}
+}
+
+// ImportState imports Terraform state into the resource.
+func (r *logsPipelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+}
+
+// duplicateSeverityValues returns the values mapped to more than one
+// severity level, which SigNoz would map to either level.
+func duplicateSeverityValues(mapping map[string]types.List) []string {
+	levels := map[string][]string{}
+	for _, level := range pipelineSeverityLevels {
+		for _, element := range mapping[level].Elements() {
+			value, ok := element.(types.String)
+			if !ok || value.IsNull() || value.IsUnknown() {
+				continue
+			}
+			levels[value.ValueString()] = append(levels[value.ValueString()], level)
+		}
+	}
+
+	var duplicates []string
+	for value, mapped := range levels {
+		if len(mapped) > 1 {
+			duplicates = append(duplicates, fmt.Sprintf("Value %q is mapped to several levels: %s.", value, strings.Join(mapped, ", ")))
+		}
+	}
+	sort.Strings(duplicates)
+
+	return duplicates
+}
+
+// onlyEnabledChanged returns true if the plan differs from the state only by
+// the enabled flag of the pipeline.
+func (m logsPipelineResourceModel) onlyEnabledChanged(state logsPipelineResourceModel) bool {
+	if m.Enabled.Equal(state.Enabled) {
+		return false
+	}
+	state.Enabled = m.Enabled
+
+	return reflect.DeepEqual(m, state)
+}
+
+// types returns the processor types set on the processor.
+func (p processorModel) types() []string {
+	set := map[string]bool{
+		model.PipelineProcessorGrokParser:     p.GrokParser != nil,
+		model.PipelineProcessorRegexParser:    p.RegexParser != nil,
+		model.PipelineProcessorJSONParser:     p.JSONParser != nil,
+		model.PipelineProcessorTraceParser:    p.TraceParser != nil,
+		model.PipelineProcessorAdd:            p.Add != nil,
+		model.PipelineProcessorRemove:         p.Remove != nil,
+		model.PipelineProcessorMove:           p.Move != nil,
+		model.PipelineProcessorCopy:           p.Copy != nil,
+		model.PipelineProcessorSeverityParser: p.SeverityParser != nil,
+	}
+
+	processorTypes := make([]string, 0, 1)
+	for _, processorType := range model.PipelineProcessorTypes {
+		if set[processorType] {
+			processorTypes = append(processorTypes, processorType)
+		}
+	}
+
+	return processorTypes
+}
+
+// toModel converts the Terraform plan to the pipeline payload.
+func (m logsPipelineResourceModel) toModel(ctx context.Context) (*model.Pipeline, error) {
+	filter, err := structure.ExpandJsonFromString(m.Filter.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := &model.Pipeline{
+		Name:        m.Name.ValueString(),
+		Alias:       m.Alias.ValueString(),
+		Description: m.Description.ValueString(),
+		Enabled:     m.Enabled.ValueBool(),
+		Filter:      filter,
+		Config:      make([]model.PipelineOperator, 0, len(m.Processors)),
+	}
+	if m.Alias.IsNull() || m.Alias.IsUnknown() {
+		pipeline.Alias = pipeline.Name
+	}
+
+	for i, processor := range m.Processors {
+		operator := model.PipelineOperator{
+			Name:    processor.Name.ValueString(),
+			OrderID: i + 1,
+			Enabled: processor.Enabled.ValueBool(),
+		}
+
+		switch {
+		case processor.GrokParser != nil:
+			operator.Type = model.PipelineProcessorGrokParser
+			operator.Pattern = processor.GrokParser.Pattern.ValueString()
+			operator.ParseFrom = processor.GrokParser.ParseFrom.ValueString()
+			operator.ParseTo = processor.GrokParser.ParseTo.ValueString()
+			operator.OnError = processor.GrokParser.OnError.ValueString()
+		case processor.RegexParser != nil:
+			operator.Type = model.PipelineProcessorRegexParser
+			operator.Regex = processor.RegexParser.Regex.ValueString()
+			operator.ParseFrom = processor.RegexParser.ParseFrom.ValueString()
+			operator.ParseTo = processor.RegexParser.ParseTo.ValueString()
+			operator.OnError = processor.RegexParser.OnError.ValueString()
+		case processor.JSONParser != nil:
+			operator.Type = model.PipelineProcessorJSONParser
+			operator.ParseFrom = processor.JSONParser.ParseFrom.ValueString()
+			operator.ParseTo = processor.JSONParser.ParseTo.ValueString()
+			operator.OnError = processor.JSONParser.OnError.ValueString()
+		case processor.TraceParser != nil:
+			operator.Type = model.PipelineProcessorTraceParser
+			operator.TraceID = toParseFrom(processor.TraceParser.TraceID)
+			operator.SpanID = toParseFrom(processor.TraceParser.SpanID)
+			operator.TraceFlags = toParseFrom(processor.TraceParser.TraceFlags)
+		case processor.Add != nil:
+			operator.Type = model.PipelineProcessorAdd
+			operator.Field = processor.Add.Field.ValueString()
+			operator.Value = processor.Add.Value.ValueString()
+		case processor.Remove != nil:
+			operator.Type = model.PipelineProcessorRemove
+			operator.Field = processor.Remove.Field.ValueString()
+		case processor.Move != nil:
+			operator.Type = model.PipelineProcessorMove
+			operator.From = processor.Move.From.ValueString()
+			operator.To = processor.Move.To.ValueString()
+		case processor.Copy != nil:
+			operator.Type = model.PipelineProcessorCopy
+			operator.From = processor.Copy.From.ValueString()
+			operator.To = processor.Copy.To.ValueString()
+		case processor.SeverityParser != nil:
+			operator.Type = model.PipelineProcessorSeverityParser
+			operator.ParseFrom = processor.SeverityParser.ParseFrom.ValueString()
+			operator.OverwriteText = processor.SeverityParser.OverwriteText.ValueBool()
+			if !processor.SeverityParser.Mapping.IsNull() {
+				diags := processor.SeverityParser.Mapping.ElementsAs(ctx, &operator.Mapping, false)
+				if diags.HasError() {
+					return nil, fmt.Errorf("invalid severity mapping of processor %s", operator.Name)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("processor %s has no type", operator.Name)
+		}
+
+		operator.ID = fmt.Sprintf("%s-%d", operator.Type, operator.OrderID)
+		pipeline.Config = append(pipeline.Config, operator)
+	}
+
+	// Processors are chained through their output.
+	for i := 0; i < len(pipeline.Config)-1; i++ {
+		pipeline.Config[i].Output = pipeline.Config[i+1].ID
+	}
+
+	return pipeline, nil
+}
+
+// fromModel sets the Terraform state from the pipeline.
+func (m *logsPipelineResourceModel) fromModel(ctx context.Context, pipeline *model.Pipeline) error {
+	filter, err := structure.FlattenJsonToString(pipeline.Filter)
+	if err != nil {
+		return err
+	}
+
+	m.ID = types.StringValue(pipeline.ID)
+	m.Name = types.StringValue(pipeline.Name)
+	m.Alias = types.StringValue(pipeline.Alias)
+	m.Description = types.StringValue(pipeline.Description)
+	m.Enabled = types.BoolValue(pipeline.Enabled)
+	m.Filter = types.StringValue(filter)
+
+	if len(pipeline.Config) == 0 {
+		m.Processors = nil
+		return nil
+	}
+
+	m.Processors = make([]processorModel, 0, len(pipeline.Config))
+	for _, operator := range pipeline.Config {
+		processor := processorModel{
+			Name:    types.StringValue(operator.Name),
+			Enabled: types.BoolValue(operator.Enabled),
+		}
+
+		switch operator.Type {
+		case model.PipelineProcessorGrokParser:
+			processor.GrokParser = &grokParserModel{
+				Pattern:   types.StringValue(operator.Pattern),
+				ParseFrom: types.StringValue(operator.ParseFrom),
+				ParseTo:   types.StringValue(operator.ParseTo),
+				OnError:   types.StringValue(operator.OnError),
+			}
+		case model.PipelineProcessorRegexParser:
+			processor.RegexParser = &regexParserModel{
+				Regex:     types.StringValue(operator.Regex),
+				ParseFrom: types.StringValue(operator.ParseFrom),
+				ParseTo:   types.StringValue(operator.ParseTo),
+				OnError:   types.StringValue(operator.OnError),
+			}
+		case model.PipelineProcessorJSONParser:
+			processor.JSONParser = &jsonParserModel{
+				ParseFrom: types.StringValue(operator.ParseFrom),
+				ParseTo:   types.StringValue(operator.ParseTo),
+				OnError:   types.StringValue(operator.OnError),
+			}
+		case model.PipelineProcessorTraceParser:
+			processor.TraceParser = &traceParserModel{
+				TraceID:    fromParseFrom(operator.TraceID),
+				SpanID:     fromParseFrom(operator.SpanID),
+				TraceFlags: fromParseFrom(operator.TraceFlags),
+			}
+		case model.PipelineProcessorAdd:
+			processor.Add = &addProcessorModel{
+				Field: types.StringValue(operator.Field),
+				Value: types.StringValue(operator.Value),
+			}
+		case model.PipelineProcessorRemove:
+			processor.Remove = &removeProcessorModel{Field: types.StringValue(operator.Field)}
+		case model.PipelineProcessorMove:
+			processor.Move = &moveProcessorModel{From: types.StringValue(operator.From), To: types.StringValue(operator.To)}
+		case model.PipelineProcessorCopy:
+			processor.Copy = &moveProcessorModel{From: types.StringValue(operator.From), To: types.StringValue(operator.To)}
+		case model.PipelineProcessorSeverityParser:
+			mapping := types.MapNull(types.ListType{ElemType: types.StringType})
+			if len(operator.Mapping) > 0 {
+				var diags diag.Diagnostics
+				mapping, diags = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, operator.Mapping)
+				if diags.HasError() {
+					return fmt.Errorf("invalid severity mapping of processor %s", operator.Name)
+				}
+			}
+			processor.SeverityParser = &severityParserModel{
+				ParseFrom:     types.StringValue(operator.ParseFrom),
+				Mapping:       mapping,
+				OverwriteText: types.BoolValue(operator.OverwriteText),
+			}
+		default:
+			return fmt.Errorf("processor %s has unsupported type %q", operator.Name, operator.Type)
+		}
+
+		m.Processors = append(m.Processors, processor)
+	}
+
+	return nil
+}
+
+// toParseFrom converts an optional trace parser field.
+func toParseFrom(value types.String) *model.PipelineParseFrom {
+	if value.IsNull() {
+		return nil
+	}
+
+	return &model.PipelineParseFrom{ParseFrom: value.ValueString()}
+}
+
+// fromParseFrom converts an optional trace parser field.
+func fromParseFrom(value *model.PipelineParseFrom) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value.ParseFrom)
+}
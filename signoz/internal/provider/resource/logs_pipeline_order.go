@@ -0,0 +1,259 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+)
+
+// pipelineOrderID - ID of the pipeline order, of which there is one per SigNoz instance.
+const pipelineOrderID = "logs_pipelines"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &logsPipelineOrderResource{}
+	_ resource.ResourceWithConfigure   = &logsPipelineOrderResource{}
+	_ resource.ResourceWithImportState = &logsPipelineOrderResource{}
+)
+
+// NewLogsPipelineOrderResource is a helper function to simplify the provider implementation.
+func NewLogsPipelineOrderResource() resource.Resource {
+	return &logsPipelineOrderResource{}
+}
+
+// logsPipelineOrderResource is the resource implementation.
+type logsPipelineOrderResource struct {
+	client *client.Client
+}
+
+// logsPipelineOrderResourceModel maps the resource schema data.
+type logsPipelineOrderResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	Pipelines []types.String `tfsdk:"pipelines"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *logsPipelineOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected resource configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			operationConfigure, SigNozLogsPipelineOrder,
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *logsPipelineOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = SigNozLogsPipelineOrder
+}
+
+// Schema defines the schema for the resource.
+func (r *logsPipelineOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the order in which SigNoz applies the log pipelines, so that signoz_logs_pipeline resources " +
+			"do not compete for positions. Only one order should be managed per SigNoz instance. Destroying this " +
+			"resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			attr.Pipelines: schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "IDs or names of the pipelines, in the order they are applied. When the order is applied, " +
+					"pipelines not listed are moved after the listed ones, keeping their relative order.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+
+			// computed.
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the pipeline order. It is always " + pipelineOrderID + ".",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *logsPipelineOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan logsPipelineOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.order(ctx, plan.Pipelines); err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozLogsPipelineOrder)
+		return
+	}
+	plan.ID = types.StringValue(pipelineOrderID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *logsPipelineOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state logsPipelineOrderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pipelines, err := r.client.GetPipelines(ctx)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozLogsPipelineOrder)
+		return
+	}
+
+	// The listed pipelines are reported in their current order, keeping the
+	// references of the state. Deleted pipelines are dropped.
+	refs := make(map[string]types.String, len(state.Pipelines))
+	for _, ref := range state.Pipelines {
+		refs[ref.ValueString()] = ref
+	}
+	current := make([]types.String, 0, len(state.Pipelines))
+	for _, pipeline := range pipelines.Pipelines {
+		if ref, ok := refs[pipeline.ID]; ok {
+			current = append(current, ref)
+		} else if ref, ok := refs[pipeline.Name]; ok {
+			current = append(current, ref)
+		}
+	}
+	if len(current) != len(state.Pipelines) {
+		tflog.Warn(ctx, "Some ordered pipelines no longer exist", map[string]any{
+			"ordered":  len(state.Pipelines),
+			"existing": len(current),
+		})
+	}
+
+	state.ID = types.StringValue(pipelineOrderID)
+	state.Pipelines = current
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *logsPipelineOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan logsPipelineOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.order(ctx, plan.Pipelines); err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, SigNozLogsPipelineOrder)
+		return
+	}
+	plan.ID = types.StringValue(pipelineOrderID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the Terraform state. The pipelines keep their order.
+func (r *logsPipelineOrderResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Pipeline order removed from state, the pipelines keep their order")
+}
+
+// ImportState imports Terraform state into the resource. The pipelines are
+// read as IDs in their current order.
+func (r *logsPipelineOrderResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pipelines, err := r.client.GetPipelines(ctx)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozLogsPipelineOrder)
+		return
+	}
+
+	refs := make([]types.String, 0, len(pipelines.Pipelines))
+	for _, pipeline := range pipelines.Pipelines {
+		refs = append(refs, types.StringValue(pipeline.ID))
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.ID), pipelineOrderID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.Pipelines), refs)...)
+}
+
+// order - Saves the pipelines with the referenced ones first, in order.
+func (r *logsPipelineOrderResource) order(ctx context.Context, refs []types.String) error {
+	tflog.Debug(ctx, "Ordering pipelines", map[string]any{"count": len(refs)})
+
+	_, err := r.client.UpdatePipelines(ctx, func(pipelines []model.Pipeline) ([]model.Pipeline, error) {
+		return orderPipelines(pipelines, refs)
+	})
+
+	return err
+}
+
+// orderPipelines - Returns the pipelines referenced by ID or name first, in
+// the order of the references, followed by the other pipelines.
+func orderPipelines(pipelines []model.Pipeline, refs []types.String) ([]model.Pipeline, error) {
+	byRef := make(map[string]int, len(pipelines))
+	ambiguous := map[string]bool{}
+	for i, pipeline := range pipelines {
+		if _, ok := byRef[pipeline.Name]; ok {
+			ambiguous[pipeline.Name] = true
+		}
+		byRef[pipeline.Name] = i
+	}
+	// IDs take precedence over names.
+	for i, pipeline := range pipelines {
+		byRef[pipeline.ID] = i
+		delete(ambiguous, pipeline.ID)
+	}
+
+	ordered := make([]model.Pipeline, 0, len(pipelines))
+	listed := make([]bool, len(pipelines))
+	var errs []error
+	for _, ref := range refs {
+		i, ok := byRef[ref.ValueString()]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("pipeline %s: %w", ref.ValueString(), client.ErrNotFound))
+		case ambiguous[ref.ValueString()]:
+			errs = append(errs, fmt.Errorf("several pipelines are named %s, reference it by ID", ref.ValueString()))
+		case listed[i]:
+			errs = append(errs, fmt.Errorf("pipeline %s is listed more than once", ref.ValueString()))
+		default:
+			listed[i] = true
+			ordered = append(ordered, pipelines[i])
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	for i, pipeline := range pipelines {
+		if !listed[i] {
+			ordered = append(ordered, pipeline)
+		}
+	}
+
+	return ordered, nil
+}
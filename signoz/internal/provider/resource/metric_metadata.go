@@ -0,0 +1,258 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &metricMetadataResource{}
+	_ resource.ResourceWithConfigure      = &metricMetadataResource{}
+	_ resource.ResourceWithImportState    = &metricMetadataResource{}
+	_ resource.ResourceWithValidateConfig = &metricMetadataResource{}
+)
+
+// NewMetricMetadataResource is a helper function to simplify the provider implementation.
+func NewMetricMetadataResource() resource.Resource {
+	return &metricMetadataResource{}
+}
+
+// metricMetadataResource is the resource implementation.
+type metricMetadataResource struct {
+	client *client.Client
+}
+
+// metricMetadataResourceModel maps the resource schema data.
+type metricMetadataResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	MetricName  types.String `tfsdk:"metric_name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Unit        types.String `tfsdk:"unit"`
+	Temporality types.String `tfsdk:"temporality"`
+	IsMonotonic types.Bool   `tfsdk:"is_monotonic"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *metricMetadataResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected resource configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			operationConfigure, SigNozMetricMetadata,
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *metricMetadataResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = SigNozMetricMetadata
+}
+
+// Schema defines the schema for the resource.
+func (r *metricMetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets the type, unit, description and temporality of a metric in SigNoz, overriding the metadata " +
+			"reported by its instrumentation. Destroying the resource keeps the last metadata in SigNoz.",
+		Attributes: map[string]schema.Attribute{
+			attr.MetricName: schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the metric, e.g. http.server.duration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			attr.Type: schema.StringAttribute{
+				Required: true,
+				Description: fmt.Sprintf("Type of the metric. Possible values are: %s.",
+					strings.Join(model.MetricTypes, ", ")),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.MetricTypes...),
+				},
+			},
+			attr.Description: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Description of the metric. By default, it is empty.",
+				Default:     stringdefault.StaticString(""),
+			},
+			attr.Unit: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Unit of the metric values, e.g. ms or By. By default, it is empty.",
+				Default:     stringdefault.StaticString(""),
+			},
+			attr.Temporality: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: fmt.Sprintf("Aggregation temporality of the metric. Possible values are: %s. By default, it is %s.",
+					strings.Join(model.MetricTemporalities, ", "), model.MetricTemporalityUnspecified),
+				Default: stringdefault.StaticString(model.MetricTemporalityUnspecified),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.MetricTemporalities...),
+				},
+			},
+			attr.IsMonotonic: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the values of a " + model.MetricTypeSum + " metric only increase. By default, it is false.",
+				Default:     booldefault.StaticBool(false),
+			},
+			// computed.
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the metadata, i.e. the name of the metric.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates that only sums are monotonic.
+func (r *metricMetadataResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config metricMetadataResourceModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Type), &config.Type)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.IsMonotonic), &config.IsMonotonic)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+	if config.IsMonotonic.ValueBool() && config.Type.ValueString() != model.MetricTypeSum {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.IsMonotonic), "Invalid monotonicity",
+			fmt.Sprintf("Only %s metrics can be monotonic, the metric is a %s.", model.MetricTypeSum, config.Type.ValueString()))
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *metricMetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan metricMetadataResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadataPayload := plan.toModel()
+
+	tflog.Debug(ctx, "Setting metric metadata", map[string]any{"metric": metadataPayload.MetricName})
+
+	err := r.client.UpdateMetricMetadata(ctx, metadataPayload)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozMetricMetadata)
+		return
+	}
+
+	plan.ID = types.StringValue(metadataPayload.MetricName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *metricMetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state metricMetadataResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadata, err := r.client.GetMetricMetadata(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		tflog.Warn(ctx, "Metric metadata not found, removing it from state", map[string]any{"metric": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozMetricMetadata)
+		return
+	}
+
+	state.MetricName = types.StringValue(metadata.MetricName)
+	state.Type = types.StringValue(metadata.Type)
+	state.Description = types.StringValue(metadata.Description)
+	state.Unit = types.StringValue(metadata.Unit)
+	state.Temporality = types.StringValue(utils.WithDefault(metadata.Temporality, model.MetricTemporalityUnspecified))
+	state.IsMonotonic = types.BoolValue(metadata.IsMonotonic)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *metricMetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan metricMetadataResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateMetricMetadata(ctx, plan.toModel())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, SigNozMetricMetadata)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the Terraform state only, as SigNoz cannot restore the
+// metadata reported by the instrumentation.
+func (r *metricMetadataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state metricMetadataResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Keeping metric metadata in SigNoz", map[string]any{"metric": state.ID.ValueString()})
+}
+
+// ImportState imports Terraform state into the resource using the metric name.
+func (r *metricMetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.MetricName), req.ID)...)
+}
+
+// toModel converts the Terraform data to the metadata payload.
+func (m metricMetadataResourceModel) toModel() *model.MetricMetadata {
+	return &model.MetricMetadata{
+		MetricName:  m.MetricName.ValueString(),
+		Type:        m.Type.ValueString(),
+		Description: m.Description.ValueString(),
+		Unit:        m.Unit.ValueString(),
+		Temporality: m.Temporality.ValueString(),
+		IsMonotonic: m.IsMonotonic.ValueBool(),
+	}
+}
@@ -0,0 +1,369 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/normalize"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &receiverRawResource{}
+	_ resource.ResourceWithConfigure      = &receiverRawResource{}
+	_ resource.ResourceWithImportState    = &receiverRawResource{}
+	_ resource.ResourceWithValidateConfig = &receiverRawResource{}
+)
+
+// NewReceiverRawResource is a helper function to simplify the provider implementation.
+func NewReceiverRawResource() resource.Resource {
+	return &receiverRawResource{}
+}
+
+// receiverRawResource is the resource implementation.
+type receiverRawResource struct {
+	client *client.Client
+}
+
+// receiverRawResourceModel maps the resource schema data.
+type receiverRawResourceModel struct {
+	AdoptExisting     types.Bool   `tfsdk:"adopt_existing"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Receiver          types.String `tfsdk:"receiver"`
+	ReceiverWO        types.String `tfsdk:"receiver_wo"`
+	ReceiverWOVersion types.Int64  `tfsdk:"receiver_wo_version"`
+	SendTest          types.Bool   `tfsdk:"send_test"`
+	Type              types.String `tfsdk:"type"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *receiverRawResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected resource configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			operationConfigure, SigNozReceiverRaw,
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *receiverRawResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = SigNozReceiverRaw
+}
+
+// Schema defines the schema for the resource.
+func (r *receiverRawResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates and manages a notification channel in SigNoz from a raw Alertmanager receiver, submitted to the " +
+			"channels API untouched. It covers channel types the provider does not model.",
+		Attributes: map[string]schema.Attribute{
+			attr.AdoptExisting: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Whether to adopt an existing channel with the name of the receiver on create, updating it " +
+					"with the receiver. Otherwise, creating a channel whose name is taken fails. By default, it is false.",
+				Default: booldefault.StaticBool(false),
+			},
+			attr.Receiver: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Alertmanager receiver JSON, such as an object with a name and slack_configs. Its name names " +
+					"the channel. It is refreshed from SigNoz only when it differs semantically. Exactly one of receiver " +
+					"and receiver_wo must be set.",
+			},
+			attr.ReceiverWO: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Description: "Alertmanager receiver JSON like receiver, which is never stored in the plan or the state, " +
+					"such as to keep webhook URLs and routing keys out of them. As it cannot be compared with the " +
+					"previous one nor refreshed from SigNoz, it is only submitted when receiver_wo_version changes.",
+			},
+			attr.ReceiverWOVersion: schema.Int64Attribute{
+				Optional: true,
+				Description: "Version of receiver_wo, required along with it. Change it, such as by incrementing it, to " +
+					"submit receiver_wo again, e.g. after rotating a secret.",
+			},
+			attr.SendTest: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Whether to send a test notification through the channel after creating or updating it, so that " +
+					"broken receivers fail the apply rather than a later alert. A channel failing the test on create is " +
+					"tainted, so that it is replaced on the next apply. By default, it is false.",
+				Default: booldefault.StaticBool(false),
+			},
+
+			// computed.
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: "Autogenerated unique ID for the channel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			attr.Name: schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the channel, as set in the receiver.",
+			},
+			attr.Type: schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the channel detected by SigNoz from the receiver, such as slack or webhook.",
+			},
+		},
+	}
+}
+
+// ValidateConfig validates that exactly one receiver is set, versioned if
+// write-only, and that it is a JSON object with a name.
+func (r *receiverRawResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config receiverRawResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Receiver.IsNull() == config.ReceiverWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.Receiver), "Invalid receiver",
+			fmt.Sprintf("Exactly one of %s and %s must be set.", attr.Receiver, attr.ReceiverWO))
+		return
+	}
+	if config.ReceiverWO.IsNull() != config.ReceiverWOVersion.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.ReceiverWOVersion), "Invalid receiver version",
+			fmt.Sprintf("%s must be set if and only if %s is set, so that changes to it can be submitted.",
+				attr.ReceiverWOVersion, attr.ReceiverWO))
+	}
+
+	receiverPath, receiver := path.Root(attr.Receiver), config.Receiver
+	if !config.ReceiverWO.IsNull() {
+		receiverPath, receiver = path.Root(attr.ReceiverWO), config.ReceiverWO
+	}
+	if receiver.IsUnknown() {
+		return
+	}
+	if _, err := model.ReceiverName(receiver.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(receiverPath, "Invalid receiver",
+			fmt.Sprintf("The receiver must be a JSON object with a name: %s.", err))
+	}
+}
+
+// configuredReceiver returns the planned receiver or, being left out of the
+// plan, the write-only receiver of the configuration.
+func configuredReceiver(ctx context.Context, plan receiverRawResourceModel, config tfsdk.Config) (string, diag.Diagnostics) {
+	if !plan.Receiver.IsNull() {
+		return plan.Receiver.ValueString(), nil
+	}
+
+	var receiver types.String
+	diags := config.GetAttribute(ctx, path.Root(attr.ReceiverWO), &receiver)
+
+	return receiver.ValueString(), diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *receiverRawResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan receiverRawResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	receiver, diags := configuredReceiver(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// SigNoz accepts channels sharing a name, which the UI then lists twice.
+	name, err := model.ReceiverName(receiver)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozReceiverRaw)
+		return
+	}
+	existing, err := r.client.FindChannel(ctx, name)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozReceiverRaw)
+		return
+	}
+
+	var channel *model.Channel
+	switch {
+	case existing != nil && !plan.AdoptExisting.ValueBool():
+		resp.Diagnostics.AddAttributeError(path.Root(attr.Receiver), "Channel already exists",
+			fmt.Sprintf("A channel named %q already exists with ID %s. Import it, or set %s to true to adopt it.",
+				name, existing.ID, attr.AdoptExisting))
+		return
+	case existing != nil:
+		tflog.Info(ctx, "Adopting existing channel", map[string]any{"channelID": existing.ID, "name": name})
+		err = r.client.UpdateChannel(ctx, string(existing.ID), receiver)
+		if err == nil {
+			channel, err = r.client.GetChannel(ctx, string(existing.ID))
+		}
+	default:
+		tflog.Debug(ctx, "Creating channel from raw receiver")
+		channel, err = r.client.CreateChannel(ctx, receiver)
+	}
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozReceiverRaw)
+		return
+	}
+
+	plan.ID = types.StringValue(string(channel.ID))
+	plan.Name = types.StringValue(channel.Name)
+	plan.Type = types.StringValue(channel.Type)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.sendTest(ctx, plan, receiver, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *receiverRawResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state receiverRawResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.GetChannel(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		tflog.Warn(ctx, "Channel not found, removing it from state", map[string]any{"channelID": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozReceiverRaw)
+		return
+	}
+
+	state.Name = types.StringValue(channel.Name)
+	state.Type = types.StringValue(channel.Type)
+
+	// Imported channels have no adoption or test setting yet.
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
+	if state.SendTest.IsNull() {
+		state.SendTest = types.BoolValue(false)
+	}
+
+	// Keep the configured receiver unless SigNoz stores a different one. A
+	// write-only receiver is left out of the state, so it cannot be compared.
+	if channel.Data != "" && state.ReceiverWOVersion.IsNull() {
+		if state.Receiver.IsNull() {
+			state.Receiver = types.StringValue(channel.Data)
+		} else {
+			comparison, err := normalize.Compare(ctx, state.Receiver.ValueString(), channel.Data,
+				normalize.Options{Subsystem: normalize.SubsystemReceiver, Cache: r.client.NormalizeCache()})
+			if err != nil || !comparison.Equal {
+				state.Receiver = types.StringValue(channel.Data)
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *receiverRawResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state receiverRawResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	receiver, diags := configuredReceiver(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateChannel(ctx, state.ID.ValueString(), receiver)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, SigNozReceiverRaw)
+		return
+	}
+
+	// The update does not return the channel, so it is read back.
+	channel, err := r.client.GetChannel(ctx, state.ID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, SigNozReceiverRaw)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Name = types.StringValue(channel.Name)
+	plan.Type = types.StringValue(channel.Type)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.sendTest(ctx, plan, receiver, &resp.Diagnostics)
+}
+
+// sendTest sends a test notification through the receiver if send_test is
+// set. It runs once the state is set, so that a failure fails the apply while
+// the channel is still tracked.
+func (r *receiverRawResource) sendTest(ctx context.Context, plan receiverRawResourceModel, receiver string, diags *diag.Diagnostics) {
+	if !plan.SendTest.ValueBool() {
+		return
+	}
+
+	tflog.Debug(ctx, "Sending test notification", map[string]any{"channelID": plan.ID.ValueString()})
+	if err := r.client.TestChannel(ctx, receiver); err != nil {
+		diags.AddAttributeError(path.Root(attr.SendTest), "Test notification failed",
+			fmt.Sprintf("The channel %q was saved, but SigNoz could not send a test notification through it: %s.",
+				plan.Name.ValueString(), err))
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *receiverRawResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state receiverRawResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteChannel(ctx, state.ID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationDelete, SigNozReceiverRaw)
+		return
+	}
+}
+
+// ImportState imports Terraform state into the resource.
+func (r *receiverRawResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+}
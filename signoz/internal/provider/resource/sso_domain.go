@@ -0,0 +1,363 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ssoDomainResource{}
+	_ resource.ResourceWithConfigure      = &ssoDomainResource{}
+	_ resource.ResourceWithImportState    = &ssoDomainResource{}
+	_ resource.ResourceWithValidateConfig = &ssoDomainResource{}
+)
+
+// NewSSODomainResource is a helper function to simplify the provider implementation.
+func NewSSODomainResource() resource.Resource {
+	return &ssoDomainResource{}
+}
+
+// ssoDomainResource is the resource implementation.
+type ssoDomainResource struct {
+	client *client.Client
+}
+
+// ssoDomainResourceModel maps the resource schema data.
+type ssoDomainResourceModel struct {
+	ID               types.String           `tfsdk:"id"`
+	Name             types.String           `tfsdk:"name"`
+	SSOEnabled       types.Bool             `tfsdk:"sso_enabled"`
+	SSOType          types.String           `tfsdk:"sso_type"`
+	SAMLConfig       *samlConfigModel       `tfsdk:"saml_config"`
+	GoogleAuthConfig *googleAuthConfigModel `tfsdk:"google_auth_config"`
+	ACSURL           types.String           `tfsdk:"acs_url"`
+}
+
+// samlConfigModel maps the SAML configuration.
+type samlConfigModel struct {
+	EntityID    types.String `tfsdk:"entity_id"`
+	IdpURL      types.String `tfsdk:"idp_url"`
+	Certificate types.String `tfsdk:"certificate"`
+}
+
+// googleAuthConfigModel maps the Google OAuth configuration.
+type googleAuthConfigModel struct {
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	RedirectURI  types.String `tfsdk:"redirect_uri"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ssoDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected resource configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			operationConfigure, SigNozSSODomain,
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *ssoDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = SigNozSSODomain
+}
+
+// Schema defines the schema for the resource.
+func (r *ssoDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates and manages domain-based SSO settings (SAML or Google OAuth) in SigNoz EE.",
+		Attributes: map[string]schema.Attribute{
+			attr.Name: schema.StringAttribute{
+				Required:    true,
+				Description: "Email domain the SSO settings apply to, e.g. example.com.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			attr.SSOEnabled: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether SSO is enforced for users of the domain. By default, it is false.",
+				Default:     booldefault.StaticBool(false),
+			},
+			attr.SSOType: schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Type of the SSO. Possible values are: %s and %s.",
+					model.SSOTypeSAML, model.SSOTypeGoogleAuth),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.SSOTypes...),
+				},
+			},
+			attr.SAMLConfig: schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("SAML configuration. Required when %s is %s.", attr.SSOType, model.SSOTypeSAML),
+				Attributes: map[string]schema.Attribute{
+					attr.EntityID: schema.StringAttribute{
+						Required:    true,
+						Description: "SAML entity ID of the identity provider.",
+					},
+					attr.IdpURL: schema.StringAttribute{
+						Required:    true,
+						Description: "SAML single sign-on URL of the identity provider.",
+					},
+					attr.Certificate: schema.StringAttribute{
+						Required:    true,
+						Description: "X.509 certificate of the identity provider.",
+					},
+				},
+			},
+			attr.GoogleAuthConfig: schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Google OAuth configuration. Required when %s is %s.", attr.SSOType, model.SSOTypeGoogleAuth),
+				Attributes: map[string]schema.Attribute{
+					attr.ClientID: schema.StringAttribute{
+						Required:    true,
+						Description: "OAuth client ID.",
+					},
+					attr.ClientSecret: schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "OAuth client secret.",
+					},
+					attr.RedirectURI: schema.StringAttribute{
+						Optional:    true,
+						Description: "OAuth redirect URI.",
+					},
+				},
+			},
+			// computed.
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: "Autogenerated unique ID for the domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			attr.ACSURL: schema.StringAttribute{
+				Computed:    true,
+				Description: "SAML assertion consumer service URL to register with the identity provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the configuration block matches the SSO type.
+func (r *ssoDomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ssoDomainResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.SSOType.IsUnknown() {
+		return
+	}
+
+	switch config.SSOType.ValueString() {
+	case model.SSOTypeSAML:
+		if config.SAMLConfig == nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.SAMLConfig), "Missing SAML configuration",
+				fmt.Sprintf("%s is required when %s is %s.", attr.SAMLConfig, attr.SSOType, model.SSOTypeSAML))
+		}
+	case model.SSOTypeGoogleAuth:
+		if config.GoogleAuthConfig == nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.GoogleAuthConfig), "Missing Google OAuth configuration",
+				fmt.Sprintf("%s is required when %s is %s.", attr.GoogleAuthConfig, attr.SSOType, model.SSOTypeGoogleAuth))
+		}
+	}
+
+	if config.SSOEnabled.ValueBool() && config.SSOType.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.SSOType), "Missing SSO type",
+			fmt.Sprintf("%s is required when %s is true.", attr.SSOType, attr.SSOEnabled))
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ssoDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ssoDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating domain", map[string]any{"domain": plan.Name.ValueString()})
+
+	// The domain is created first and configured with a follow-up update.
+	domain, err := r.client.CreateDomain(ctx, &model.Domain{Name: plan.Name.ValueString()})
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozSSODomain)
+		return
+	}
+
+	domainPayload := plan.toModel()
+	domainPayload.ID = domain.ID
+	domainPayload.OrgID = domain.OrgID
+	err = r.client.UpdateDomain(ctx, domain.ID, domainPayload)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozSSODomain)
+		return
+	}
+
+	plan.ID = types.StringValue(domain.ID)
+	plan.ACSURL = types.StringValue(r.client.SAMLACSURL())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ssoDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ssoDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := r.client.GetDomain(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		tflog.Warn(ctx, "Domain not found, removing it from state", map[string]any{"domainID": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozSSODomain)
+		return
+	}
+
+	state.Name = types.StringValue(domain.Name)
+	state.SSOEnabled = types.BoolValue(domain.SSOEnabled)
+	state.SSOType = types.StringNull()
+	if domain.SSOType != "" {
+		state.SSOType = types.StringValue(domain.SSOType)
+	}
+	state.ACSURL = types.StringValue(r.client.SAMLACSURL())
+
+	state.SAMLConfig = nil
+	if domain.SAMLConfig != nil && domain.SAMLConfig.SAMLEntity != "" {
+		state.SAMLConfig = &samlConfigModel{
+			EntityID:    types.StringValue(domain.SAMLConfig.SAMLEntity),
+			IdpURL:      types.StringValue(domain.SAMLConfig.SAMLIdp),
+			Certificate: types.StringValue(domain.SAMLConfig.SAMLCert),
+		}
+	}
+
+	// The API may mask the client secret, so keep the configured one.
+	previousGoogle := state.GoogleAuthConfig
+	state.GoogleAuthConfig = nil
+	if domain.GoogleAuthConfig != nil && domain.GoogleAuthConfig.ClientID != "" {
+		google := &googleAuthConfigModel{
+			ClientID:     types.StringValue(domain.GoogleAuthConfig.ClientID),
+			ClientSecret: types.StringValue(domain.GoogleAuthConfig.ClientSecret),
+			RedirectURI:  types.StringNull(),
+		}
+		if domain.GoogleAuthConfig.RedirectURI != "" {
+			google.RedirectURI = types.StringValue(domain.GoogleAuthConfig.RedirectURI)
+		}
+		if previousGoogle != nil && (domain.GoogleAuthConfig.ClientSecret == "" || strings.Trim(domain.GoogleAuthConfig.ClientSecret, "*") == "") {
+			google.ClientSecret = previousGoogle.ClientSecret
+		}
+		state.GoogleAuthConfig = google
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ssoDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ssoDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainPayload := plan.toModel()
+	domainPayload.ID = state.ID.ValueString()
+	err := r.client.UpdateDomain(ctx, state.ID.ValueString(), domainPayload)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, SigNozSSODomain)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.ACSURL = types.StringValue(r.client.SAMLACSURL())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ssoDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ssoDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDomain(ctx, state.ID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationDelete, SigNozSSODomain)
+		return
+	}
+}
+
+// ImportState imports Terraform state into the resource.
+func (r *ssoDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+}
+
+// toModel converts the resource data to the API model.
+func (m ssoDomainResourceModel) toModel() *model.Domain {
+	domain := &model.Domain{
+		Name:       m.Name.ValueString(),
+		SSOEnabled: m.SSOEnabled.ValueBool(),
+		SSOType:    m.SSOType.ValueString(),
+	}
+
+	if m.SAMLConfig != nil {
+		domain.SAMLConfig = &model.SAMLConfig{
+			SAMLEntity: m.SAMLConfig.EntityID.ValueString(),
+			SAMLIdp:    m.SAMLConfig.IdpURL.ValueString(),
+			SAMLCert:   m.SAMLConfig.Certificate.ValueString(),
+		}
+	}
+
+	if m.GoogleAuthConfig != nil {
+		domain.GoogleAuthConfig = &model.GoogleAuthConfig{
+			ClientID:     m.GoogleAuthConfig.ClientID.ValueString(),
+			ClientSecret: m.GoogleAuthConfig.ClientSecret.ValueString(),
+			RedirectURI:  m.GoogleAuthConfig.RedirectURI.ValueString(),
+		}
+	}
+
+	return domain
+}
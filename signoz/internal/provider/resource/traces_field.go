@@ -0,0 +1,256 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &tracesFieldResource{}
+	_ resource.ResourceWithConfigure   = &tracesFieldResource{}
+	_ resource.ResourceWithImportState = &tracesFieldResource{}
+)
+
+// NewTracesFieldResource is a helper function to simplify the provider implementation.
+func NewTracesFieldResource() resource.Resource {
+	return &tracesFieldResource{}
+}
+
+// tracesFieldResource is the resource implementation.
+type tracesFieldResource struct {
+	client *client.Client
+}
+
+// tracesFieldResourceModel maps the resource schema data.
+type tracesFieldResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	DataType         types.String `tfsdk:"data_type"`
+	IndexType        types.String `tfsdk:"index_type"`
+	IndexGranularity types.Int64  `tfsdk:"index_granularity"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *tracesFieldResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected resource configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			operationConfigure, SigNozTracesField,
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *tracesFieldResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = SigNozTracesField
+}
+
+// Schema defines the schema for the resource.
+func (r *tracesFieldResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Selects a span attribute so it is materialized as an indexed column in SigNoz, speeding up trace queries " +
+			"filtering or grouping by it. " +
+			"Destroying the resource drops the column. Changing any attribute recreates the column.",
+		Attributes: map[string]schema.Attribute{
+			attr.Name: schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the span attribute.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			attr.FieldType: schema.StringAttribute{
+				Required: true,
+				Description: fmt.Sprintf("Whether the field is a span or a resource attribute. Possible values are: %s and %s.",
+					model.LogsFieldTypeAttributes, model.LogsFieldTypeResources),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.LogsFieldTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			attr.DataType: schema.StringAttribute{
+				Required: true,
+				Description: fmt.Sprintf("Data type of the field. Possible values are: %s, %s, %s, and %s.",
+					model.LogsFieldDataTypeString, model.LogsFieldDataTypeInt64, model.LogsFieldDataTypeFloat64, model.LogsFieldDataTypeBool),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.LogsFieldDataTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			attr.IndexType: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ClickHouse skip index type of the column. By default, it is " + logsFieldDefaultIndexType + ".",
+				Default:     stringdefault.StaticString(logsFieldDefaultIndexType),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			attr.IndexGranularity: schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("Granularity of the skip index. By default, it is %d.", logsFieldDefaultIndexGranularity),
+				Default:     int64default.StaticInt64(logsFieldDefaultIndexGranularity),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			// computed.
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the field in the form type/name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *tracesFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tracesFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldPayload := plan.toModel()
+	fieldPayload.Selected = true
+
+	tflog.Debug(ctx, "Selecting span field", map[string]any{"field": fieldPayload.Name, "type": fieldPayload.Type})
+
+	err := r.client.UpdateTracesField(ctx, fieldPayload)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozTracesField)
+		return
+	}
+
+	plan.ID = types.StringValue(fieldPayload.Type + "/" + fieldPayload.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *tracesFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tracesFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldType, name, ok := strings.Cut(state.ID.ValueString(), "/")
+	if !ok {
+		addErr(&resp.Diagnostics, fmt.Errorf("invalid ID %q, expected type/name", state.ID.ValueString()), operationRead, SigNozTracesField)
+		return
+	}
+
+	field, err := r.client.GetTracesField(ctx, name, fieldType)
+	if errors.Is(err, client.ErrNotFound) {
+		tflog.Warn(ctx, "Span field not selected, removing it from state", map[string]any{"field": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozTracesField)
+		return
+	}
+
+	state.Name = types.StringValue(field.Name)
+	state.Type = types.StringValue(field.Type)
+	state.DataType = types.StringValue(strings.ToLower(field.DataType))
+	// The fields API does not report the index settings.
+	if state.IndexType.IsNull() {
+		state.IndexType = types.StringValue(logsFieldDefaultIndexType)
+	}
+	if state.IndexGranularity.IsNull() {
+		state.IndexGranularity = types.Int64Value(logsFieldDefaultIndexGranularity)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is not supported, as every attribute requires replacement.
+func (r *tracesFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tracesFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete unselects the field and removes the Terraform state on success.
+func (r *tracesFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tracesFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldPayload := state.toModel()
+	fieldPayload.Selected = false
+
+	err := r.client.UpdateTracesField(ctx, fieldPayload)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationDelete, SigNozTracesField)
+		return
+	}
+}
+
+// ImportState imports Terraform state into the resource using an ID in the form type/name.
+func (r *tracesFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+}
+
+// toModel converts the Terraform data to the field payload.
+func (m tracesFieldResourceModel) toModel() *model.TracesField {
+	return &model.TracesField{
+		Name:             m.Name.ValueString(),
+		Type:             m.Type.ValueString(),
+		DataType:         m.DataType.ValueString(),
+		IndexType:        m.IndexType.ValueString(),
+		IndexGranularity: m.IndexGranularity.ValueInt64(),
+	}
+}
@@ -0,0 +1,204 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userRoleResource{}
+	_ resource.ResourceWithConfigure   = &userRoleResource{}
+	_ resource.ResourceWithImportState = &userRoleResource{}
+)
+
+// NewUserRoleResource is a helper function to simplify the provider implementation.
+func NewUserRoleResource() resource.Resource {
+	return &userRoleResource{}
+}
+
+// userRoleResource is the resource implementation.
+type userRoleResource struct {
+	client *client.Client
+}
+
+// userRoleResourceModel maps the resource schema data.
+type userRoleResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	UserID        types.String `tfsdk:"user_id"`
+	Role          types.String `tfsdk:"role"`
+	RoleOnDestroy types.String `tfsdk:"role_on_destroy"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		addErr(
+			&resp.Diagnostics,
+			fmt.Errorf("unexpected resource configure type. Expected *client.Client, got: %T. "+
+				"Please report this issue to the provider developers", req.ProviderData),
+			operationConfigure, SigNozUserRole,
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *userRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = SigNozUserRole
+}
+
+// Schema defines the schema for the resource.
+func (r *userRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	roles := fmt.Sprintf("Possible values are: %s, %s, and %s.", model.UserRoleAdmin, model.UserRoleEditor, model.UserRoleViewer)
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the role of an existing SigNoz user, e.g. a user provisioned through SSO. " +
+			"The user itself is not created or deleted by this resource.",
+		Attributes: map[string]schema.Attribute{
+			attr.UserID: schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			attr.Role: schema.StringAttribute{
+				Required:    true,
+				Description: "Role assigned to the user. " + roles,
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.UserRoles...),
+				},
+			},
+			attr.RoleOnDestroy: schema.StringAttribute{
+				Optional: true,
+				Description: "Role assigned to the user when the resource is destroyed. " +
+					"By default, the role is left unchanged. " + roles,
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.UserRoles...),
+				},
+			},
+			// computed.
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the user role assignment. It is the same as the user ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Assigning user role", map[string]any{"userID": plan.UserID.ValueString(), "role": plan.Role.ValueString()})
+
+	err := r.client.UpdateUserRole(ctx, plan.UserID.ValueString(), plan.Role.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, SigNozUserRole)
+		return
+	}
+
+	plan.ID = plan.UserID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userRole, err := r.client.GetUserRole(ctx, state.ID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, SigNozUserRole)
+		return
+	}
+
+	state.UserID = types.StringValue(userRole.UserID)
+	state.Role = types.StringValue(userRole.GroupName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Role.ValueString() != state.Role.ValueString() {
+		err := r.client.UpdateUserRole(ctx, state.ID.ValueString(), plan.Role.ValueString())
+		if err != nil {
+			addErr(&resp.Diagnostics, err, operationUpdate, SigNozUserRole)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete applies role_on_destroy, if set, and removes the Terraform state.
+func (r *userRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.RoleOnDestroy.IsNull() {
+		return
+	}
+
+	err := r.client.UpdateUserRole(ctx, state.ID.ValueString(), state.RoleOnDestroy.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationDelete, SigNozUserRole)
+		return
+	}
+}
+
+// ImportState imports Terraform state into the resource using the user ID.
+func (r *userRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.UserID), req.ID)...)
+}
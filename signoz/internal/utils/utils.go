@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"sort"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -67,3 +69,19 @@ func Contains[T comparable](items []T, element T) bool {
 
 	return false
 }
+
+// SortedKeys - returns the keys of the map in ascending order.
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Ptr - returns a pointer to the value.
+func Ptr[T any](value T) *T {
+	return &value
+}
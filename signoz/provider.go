@@ -4,46 +4,92 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/attr"
 	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/client"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/model"
+	"github.com/SigNoz/terraform-provider-signoz/signoz/internal/normalize"
 	signozdatasource "github.com/SigNoz/terraform-provider-signoz/signoz/internal/provider/datasource"
+	signozfunction "github.com/SigNoz/terraform-provider-signoz/signoz/internal/provider/function"
 	signozresource "github.com/SigNoz/terraform-provider-signoz/signoz/internal/provider/resource"
 )
 
 const (
-	DefaultHTTPTimeout  = 35
-	DefaultHTTPMaxRetry = 10
-	DefaultURL          = "http://localhost:3301"
+	DefaultHTTPTimeout      = 35
+	DefaultHTTPMaxRetry     = 10
+	DefaultIdleTimeout      = 90
+	DefaultParallelism      = 10
+	DefaultURL              = "http://localhost:3301"
+	DefaultBreakerThreshold = 10
 
 	// Environment variables.
 	EnvAccessToken  = "SIGNOZ_ACCESS_TOKEN" // #nosec G101
 	EnvEndpoint     = "SIGNOZ_ENDPOINT"
 	EnvHTTPMaxRetry = "SIGNOZ_HTTP_MAX_RETRY"
 	EnvHTTPTimeout  = "SIGNOZ_HTTP_TIMEOUT"
+	EnvParallelism  = "SIGNOZ_PARALLELISM"
+	EnvMock         = "SIGNOZ_MOCK"
+	EnvDryRun       = "SIGNOZ_DRY_RUN"
+	EnvMetrics      = "SIGNOZ_METRICS"
+	EnvMetricsFile  = "SIGNOZ_METRICS_FILE"
+	EnvSkipNoop     = "SIGNOZ_SKIP_NOOP_UPDATES"
+	EnvBreaker      = "SIGNOZ_CIRCUIT_BREAKER_THRESHOLD"
+	EnvCompression  = "SIGNOZ_HTTP_COMPRESSION"
+	EnvDisableHTTP2 = "SIGNOZ_HTTP_DISABLE_HTTP2"
+	EnvIdleTimeout  = "SIGNOZ_HTTP_IDLE_CONN_TIMEOUT"
+	EnvMaxBodySize  = "SIGNOZ_HTTP_MAX_BODY_SIZE"
+	EnvMaxIdleConns = "SIGNOZ_HTTP_MAX_IDLE_CONNS_PER_HOST"
+	EnvTranscript   = "SIGNOZ_TF_HTTP_TRANSCRIPT"
+	EnvNormWarnings = "SIGNOZ_NORMALIZATION_WARNINGS"
 )
 
 // signozProviderModel maps provider schema data to a Go type.
 type signozProviderModel struct {
-	AccessToken  types.String `tfsdk:"access_token"`
-	Endpoint     types.String `tfsdk:"endpoint"`
-	HTTPMaxRetry types.Int64  `tfsdk:"http_max_retry"`
-	HTTPTimeout  types.Int64  `tfsdk:"http_timeout"`
+	AccessToken                     types.String `tfsdk:"access_token"`
+	AlertDisabledNormalizationRules types.List   `tfsdk:"alert_disabled_normalization_rules"`
+	AlertIgnoreConditionFields      types.List   `tfsdk:"alert_ignore_condition_fields"`
+	AlertManagedByLabel             types.String `tfsdk:"alert_managed_by_label"`
+	AlertMetadataLabels             types.Map    `tfsdk:"alert_metadata_labels"`
+	CircuitBreakerThreshold         types.Int64  `tfsdk:"circuit_breaker_threshold"`
+	DefaultDashboardTags            types.List   `tfsdk:"default_dashboard_tags"`
+	DryRun                          types.Bool   `tfsdk:"dry_run"`
+	Endpoint                        types.String `tfsdk:"endpoint"`
+	HTTPCompression                 types.Bool   `tfsdk:"http_compression"`
+	HTTPDisableHTTP2                types.Bool   `tfsdk:"http_disable_http2"`
+	HTTPIdleConnTimeout             types.Int64  `tfsdk:"http_idle_conn_timeout"`
+	HTTPMaxBodySize                 types.Int64  `tfsdk:"http_max_body_size"`
+	HTTPMaxIdleConnsPerHost         types.Int64  `tfsdk:"http_max_idle_conns_per_host"`
+	HTTPMaxRetry                    types.Int64  `tfsdk:"http_max_retry"`
+	HTTPTimeout                     types.Int64  `tfsdk:"http_timeout"`
+	Parallelism                     types.Int64  `tfsdk:"parallelism"`
+	Metrics                         types.Bool   `tfsdk:"metrics"`
+	MetricsFile                     types.String `tfsdk:"metrics_file"`
+	Mock                            types.Bool   `tfsdk:"mock"`
+	NormalizationWarnings           types.Bool   `tfsdk:"normalization_warnings"`
+	SkipNoopUpdates                 types.Bool   `tfsdk:"skip_noop_updates"`
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &signozProvider{}
+	_ provider.Provider              = &signozProvider{}
+	_ provider.ProviderWithFunctions = &signozProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -84,11 +130,109 @@ func (p *signozProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					"with Admin Role ([documentation](https://signoz.io/newsroom/launch-week-1-day-5/#using-access-token)).\n"+
 					"Also, you can set it using environment variable %s.", EnvAccessToken),
 			},
+			attr.AlertDisabledNormalizationRules: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Rules not applied when normalizing the conditions of every signoz_alert, in addition to the\n" +
+					"disabled_normalization_rules of each alert. Each rule is named after the field it removes when it holds\n" +
+					"the default added by SigNoz, such as hidden for hidden set to true, which is kept once the rule is\n" +
+					"disabled. Possible values are: " + strings.Join(normalize.AlertConditionRules(), ", ") + ".",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(normalize.AlertConditionRules()...)),
+				},
+			},
+			attr.AlertIgnoreConditionFields: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Alert condition fields ignored when detecting drift on every signoz_alert, in addition to the\n" +
+					"ignore_condition_fields of each alert. A key such as hidden matches the key at any depth, and a dotted\n" +
+					"path such as compositeQuery.builderQueries.*.stepInterval matches from the root of the condition.",
+			},
+			attr.AlertManagedByLabel: schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Label stamped on every alert to mark it as managed by Terraform, in the form key:value. "+
+					"It is hidden from the labels of the alerts. Set it to an empty string to disable it. If not set, it defaults to %s.",
+					model.AlertTerraformLabel),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^$|^[^:]+:.+$`), "alert managed-by label should be of the form key:value"),
+				},
+			},
+			attr.AlertMetadataLabels: schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Labels stamped on every alert, such as the Terraform workspace or run ID. Like the managed-by label, " +
+					"they are hidden from the labels of the alerts, so changing them does not cause drift.",
+			},
+			attr.CircuitBreakerThreshold: schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("Specifies the number of consecutive failed HTTP requests to SigNoz, i.e. connection errors\n"+
+					"and 5xx responses counting every retry, after which requests fail fast with a diagnostic instead of each\n"+
+					"retrying on its own. SigNoz is tried again after 30 seconds. Set it to 0 to disable it.\n"+
+					"Also, you can set it using environment variable %s. If not set, it defaults to %d.", EnvBreaker, DefaultBreakerThreshold),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			attr.DefaultDashboardTags: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Tags added to every dashboard, such as terraform or team:platform, unless the dashboard sets " +
+					"skip_default_tags. Like the alert metadata labels, they are hidden from the tags of the dashboards, " +
+					"unless listed there as well, so changing them does not cause drift.",
+			},
+			attr.DryRun: schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Whether to only log the requests creating, updating and deleting objects in SigNoz instead of\n"+
+					"sending them. The payloads are logged at the INFO level exactly as they would be sent, after normalization,\n"+
+					"and each write fails with an error diagnostic showing its payload, so that the state is left untouched.\n"+
+					"Reads are still sent to SigNoz, so plans are unaffected.\n"+
+					"Also, you can set it using environment variable %s. If not set, it defaults to false.", EnvDryRun),
+			},
 			attr.Endpoint: schema.StringAttribute{
 				Optional: true,
 				Description: fmt.Sprintf("Endpoint of the SigNoz. It is the root URL of the SigNoz UI.\n"+
 					"Also, you can set it using environment variable %s. If not set, it defaults to %s.", EnvEndpoint, DefaultURL),
 			},
+			attr.HTTPCompression: schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Whether to gzip compress request bodies larger than 1 KiB, such as large dashboards.\n"+
+					"Responses are always requested with gzip encoding. Enable it only if SigNoz or the proxy in front of it\n"+
+					"accepts compressed request bodies. Also, you can set it using environment variable %s.\n"+
+					"If not set, it defaults to false.", EnvCompression),
+			},
+			attr.HTTPDisableHTTP2: schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Whether to restrict the connections to SigNoz to HTTP/1.1, for instance behind a proxy\n"+
+					"with a broken HTTP/2 implementation. Also, you can set it using environment variable %s.\n"+
+					"If not set, it defaults to false.", EnvDisableHTTP2),
+			},
+			attr.HTTPIdleConnTimeout: schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("Specifies how long in seconds an idle connection to SigNoz is kept open for reuse.\n"+
+					"Also, you can set it using environment variable %s. If not set, it defaults to %d.", EnvIdleTimeout, DefaultIdleTimeout),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			attr.HTTPMaxBodySize: schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("Specifies the max size in bytes of the request bodies sent to SigNoz, after compression.\n"+
+					"Larger requests, such as oversized dashboards, fail before being sent with a diagnostic naming their size.\n"+
+					"Set it to the body size limit of the proxy in front of SigNoz. Also, you can set it using environment\n"+
+					"variable %s. If not set, request bodies are not limited.", EnvMaxBodySize),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			attr.HTTPMaxIdleConnsPerHost: schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("Specifies the max number of idle connections kept open to SigNoz for reuse.\n"+
+					"Raise it along with the parallelism to avoid reconnecting between requests.\n"+
+					"Also, you can set it using environment variable %s. If not set, it defaults to the parallelism.", EnvMaxIdleConns),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			attr.HTTPMaxRetry: schema.Int64Attribute{
 				Optional: true,
 				Description: fmt.Sprintf("Specifies the max retry limit for the HTTP requests made to SigNoz.\n"+
@@ -99,6 +243,50 @@ func (p *signozProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Description: fmt.Sprintf("Specifies the timeout limit in seconds for the HTTP requests made to SigNoz.\n"+
 					"Also, you can set it using environment variable %s. If not set, it defaults to %d.", EnvHTTPTimeout, DefaultHTTPTimeout),
 			},
+			attr.Metrics: schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Whether to log the duration, attempts, wait for a free connection and body sizes of every\n"+
+					"HTTP request made to SigNoz, at the INFO level. Use it to tell slow applies caused by SigNoz apart from\n"+
+					"those caused by the parallelism or retries. Also, you can set it using environment variable %s.\n"+
+					"If not set, it defaults to false.", EnvMetrics),
+			},
+			attr.MetricsFile: schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Path of a file the request metrics are appended to as JSON lines. Setting it enables the\n"+
+					"metrics. Also, you can set it using environment variable %s.", EnvMetricsFile),
+			},
+			attr.Mock: schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Serves every request from an in-memory mock of the SigNoz API instead of a live instance.\n"+
+					"Intended requests are logged and responses are fabricated deterministically, which enables module\n"+
+					"tests and plans in CI. The access token is not required in this mode.\n"+
+					"Also, you can set it using environment variable %s. If not set, it defaults to false.", EnvMock),
+			},
+			attr.NormalizationWarnings: schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Whether to report the JSON fields the provider leaves out when comparing alert conditions\n"+
+					"as warnings: the defaults added by SigNoz, such as hidden or an empty groupBy, when they hide a difference\n"+
+					"from the plan, and the defaults left out of imported conditions. Use it to review what the provider decided\n"+
+					"to ignore. Also, you can set it using environment variable %s. If not set, it defaults to false.", EnvNormWarnings),
+			},
+			attr.Parallelism: schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("Specifies the max number of concurrent HTTP requests and connections to SigNoz.\n"+
+					"Reads of different resources, such as large dashboards, overlap up to this limit.\n"+
+					"Writes to the same alert or dashboard are always serialized.\n"+
+					"Terraform does not pass its -parallelism flag to providers, so set both to match.\n"+
+					"Also, you can set it using environment variable %s. If not set, it defaults to %d.", EnvParallelism, DefaultParallelism),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			attr.SkipNoopUpdates: schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Whether to read alerts and dashboards before updating them and skip the update when SigNoz\n"+
+					"already stores the same content, once normalized. It avoids bumping the update time and writing audit\n"+
+					"logs for formatting-only changes, at the cost of one more read per update.\n"+
+					"Also, you can set it using environment variable %s. If not set, it defaults to false.", EnvSkipNoop),
+			},
 		},
 	}
 }
@@ -120,10 +308,57 @@ func (p *signozProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	endpoint := overrideStrWithConfig(config.Endpoint, os.Getenv(EnvEndpoint), DefaultURL)
 	httpMaxRetry := overrideIntWithConfig(config.HTTPMaxRetry, mustGetInt(os.Getenv(EnvHTTPMaxRetry)), DefaultHTTPMaxRetry)
 	httpTimeout := overrideIntWithConfig(config.HTTPTimeout, mustGetInt(os.Getenv(EnvHTTPTimeout)), DefaultHTTPTimeout)
+	parallelism := overrideIntWithConfig(config.Parallelism, mustGetInt(os.Getenv(EnvParallelism)), DefaultParallelism)
+	mock := overrideBoolWithConfig(config.Mock, mustGetBool(os.Getenv(EnvMock)))
+	dryRun := overrideBoolWithConfig(config.DryRun, mustGetBool(os.Getenv(EnvDryRun)))
+	normalizationWarnings := overrideBoolWithConfig(config.NormalizationWarnings, mustGetBool(os.Getenv(EnvNormWarnings)))
+	metrics := overrideBoolWithConfig(config.Metrics, mustGetBool(os.Getenv(EnvMetrics)))
+	metricsFile := overrideStrWithConfig(config.MetricsFile, os.Getenv(EnvMetricsFile))
+	// Zero disables the circuit breaker, so it is only defaulted if the variable is unset.
+	breakerThreshold := overrideIntWithConfig(config.CircuitBreakerThreshold, lookupInt(EnvBreaker, DefaultBreakerThreshold))
+	skipNoopUpdates := overrideBoolWithConfig(config.SkipNoopUpdates, mustGetBool(os.Getenv(EnvSkipNoop)))
+	httpCompression := overrideBoolWithConfig(config.HTTPCompression, mustGetBool(os.Getenv(EnvCompression)))
+	httpDisableHTTP2 := overrideBoolWithConfig(config.HTTPDisableHTTP2, mustGetBool(os.Getenv(EnvDisableHTTP2)))
+	httpIdleConnTimeout := overrideIntWithConfig(config.HTTPIdleConnTimeout, mustGetInt(os.Getenv(EnvIdleTimeout)), DefaultIdleTimeout)
+	httpMaxBodySize := overrideIntWithConfig(config.HTTPMaxBodySize, mustGetInt(os.Getenv(EnvMaxBodySize)))
+	httpMaxIdleConnsPerHost := overrideIntWithConfig(config.HTTPMaxIdleConnsPerHost, mustGetInt(os.Getenv(EnvMaxIdleConns)), parallelism)
+
+	var ignoreConditionFields []string
+	resp.Diagnostics.Append(config.AlertIgnoreConditionFields.ElementsAs(ctx, &ignoreConditionFields, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var disabledNormalizationRules []string
+	resp.Diagnostics.Append(config.AlertDisabledNormalizationRules.ElementsAs(ctx, &disabledNormalizationRules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var defaultDashboardTags []string
+	resp.Diagnostics.Append(config.DefaultDashboardTags.ElementsAs(ctx, &defaultDashboardTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managedAlertLabels := map[string]string{}
+	resp.Diagnostics.Append(config.AlertMetadataLabels.ElementsAs(ctx, &managedAlertLabels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	managedByLabel := overrideStrWithConfig(config.AlertManagedByLabel, model.AlertTerraformLabel)
+	if managedByLabel != "" {
+		key, value, err := model.ParseAlertLabel(managedByLabel)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.AlertManagedByLabel), "Invalid "+attr.AlertManagedByLabel, err.Error())
+			return
+		}
+		managedAlertLabels[key] = value
+	}
 
 	// Check if the SigNoz access token has been set in the configuration or
 	// environment variables. If not, return an error.
-	if accessToken == "" {
+	if accessToken == "" && !mock {
 		resp.Diagnostics.AddAttributeError(
 			path.Root(attr.AccessToken),
 			"Missing SigNoz "+attr.AccessToken,
@@ -143,12 +378,34 @@ func (p *signozProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		httpMaxRetry,
 		p.terraformAgent,
 		p.version,
+		client.WithParallelism(parallelism),
+		client.WithMock(mock),
+		client.WithDryRun(dryRun),
+		client.WithMetrics(metrics, metricsFile),
+		client.WithTranscript(os.Getenv(EnvTranscript)),
+		client.WithSkipNoopUpdates(skipNoopUpdates),
+		client.WithBreakerThreshold(breakerThreshold),
+		client.WithCompression(httpCompression),
+		client.WithMaxBodySize(int64(httpMaxBodySize)),
+		client.WithMaxIdleConnsPerHost(httpMaxIdleConnsPerHost),
+		client.WithIdleConnTimeout(time.Duration(httpIdleConnTimeout)*time.Second),
+		client.WithDisableHTTP2(httpDisableHTTP2),
+		client.WithIgnoreConditionFields(ignoreConditionFields),
+		client.WithDisabledNormalizationRules(disabledNormalizationRules),
+		client.WithManagedAlertLabels(managedAlertLabels),
+		client.WithDefaultDashboardTags(defaultDashboardTags),
+		client.WithNormalizationWarnings(normalizationWarnings),
 	)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create SigNoz API client", err.Error())
 		return
 	}
 
+	// Detect the server version to select the matching alert payload version.
+	if err := client.NegotiateAPIVersion(ctx); err != nil {
+		resp.Diagnostics.AddWarning("Unable to detect SigNoz version", err.Error())
+	}
+
 	// Make the SigNoz client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -161,7 +418,14 @@ func (p *signozProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *signozProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		signozdatasource.NewAlertDataSource,
+		signozdatasource.NewAlertsDataSource,
 		signozdatasource.NewDashboardDataSource,
+		signozdatasource.NewDashboardTemplateDataSource,
+		signozdatasource.NewLogsPipelineHistoryDataSource,
+		signozdatasource.NewLogsPipelinesDataSource,
+		signozdatasource.NewQueryRangeDataSource,
+		signozdatasource.NewRolesDataSource,
+		signozdatasource.NewUsersDataSource,
 	}
 }
 
@@ -169,7 +433,32 @@ func (p *signozProvider) DataSources(_ context.Context) []func() datasource.Data
 func (p *signozProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		signozresource.NewAlertResource,
+		signozresource.NewAlertSetResource,
+		signozresource.NewAlertSilenceResource,
 		signozresource.NewDashboardResource,
+		signozresource.NewLicenseResource,
+		signozresource.NewLogsFieldResource,
+		signozresource.NewLogsPipelineResource,
+		signozresource.NewLogsPipelineOrderResource,
+		signozresource.NewMetricMetadataResource,
+		signozresource.NewReceiverRawResource,
+		signozresource.NewSSODomainResource,
+		signozresource.NewTracesFieldResource,
+		signozresource.NewUserRoleResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *signozProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		signozfunction.NewCollectorProcessorsFunction,
+		signozfunction.NewConvertUnitFunction,
+		signozfunction.NewDowntimeScheduleFunction,
+		signozfunction.NewDurationFunction,
+		signozfunction.NewMergeWidgetsFunction,
+		signozfunction.NewNormalizeJSONFunction,
+		signozfunction.NewRenderWidgetFunction,
+		signozfunction.NewThresholdConditionFunction,
 	}
 }
 
@@ -182,6 +471,29 @@ func mustGetInt(str string) int {
 	return 0
 }
 
+// lookupInt - convert the environment variable to int, or return the default
+// if it is unset or invalid. Unlike mustGetInt, zero is a valid value.
+func lookupInt(key string, defaultValue int) int {
+	str, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+	if val, err := strconv.Atoi(str); err == nil {
+		return val
+	}
+
+	return defaultValue
+}
+
+// mustGetBool - convert string to bool or return false.
+func mustGetBool(str string) bool {
+	if val, err := strconv.ParseBool(str); err == nil {
+		return val
+	}
+
+	return false
+}
+
 // overrideStrWithConfig - Override string with config or return non-zero value default.
 func overrideStrWithConfig(cfg types.String, defaultValue ...string) string {
 	if !cfg.IsNull() {
@@ -211,3 +523,12 @@ func overrideIntWithConfig(cfg types.Int64, defaultValue ...int) int {
 
 	return 0
 }
+
+// overrideBoolWithConfig - Override bool with config or return default.
+func overrideBoolWithConfig(cfg types.Bool, defaultValue bool) bool {
+	if !cfg.IsNull() {
+		return cfg.ValueBool()
+	}
+
+	return defaultValue
+}